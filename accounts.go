@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -40,8 +41,8 @@ func (c *Client) CreateAccount(ctx context.Context, payload models.Account) (*mo
 	return &account, nil
 }
 
-func (c *Client) ListAccounts(ctx context.Context) (models.Accounts, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/accounts", c.hostURL), nil)
+func (c *Client) GetAccount(ctx context.Context, accountID uuid.UUID) (*models.Account, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/accounts/%s", c.hostURL, accountID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -51,14 +52,36 @@ func (c *Client) ListAccounts(ctx context.Context) (models.Accounts, error) {
 		return nil, err
 	}
 
-	accounts := models.Accounts{}
-	if err = json.Unmarshal(resp, &accounts); err != nil {
+	account := models.Account{}
+	if err = json.Unmarshal(resp, &account); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// ListAccounts lists all the accounts, fetching every page.
+func (c *Client) ListAccounts(ctx context.Context, options ...ListOption) (models.Accounts, error) {
+	accounts, err := Collect(c.AllAccounts(ctx, options...), 0)
+	if err != nil {
 		return nil, err
 	}
 
 	return accounts, nil
 }
 
+// AllAccounts returns an iterator over the accounts which lazily fetches the pages.
+func (c *Client) AllAccounts(ctx context.Context, options ...ListOption) iter.Seq2[models.Account, error] {
+	return paginate(ctx, c, fmt.Sprintf("%s/accounts", c.hostURL), options, func(resp []byte) ([]models.Account, *models.Pagination, error) {
+		accounts := models.Accounts{}
+		if err := json.Unmarshal(resp, &accounts); err != nil {
+			return nil, nil, err
+		}
+
+		return accounts, nil, nil
+	})
+}
+
 func (c *Client) DeleteAccount(ctx context.Context, accountID uuid.UUID) error {
 	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/accounts/%s", c.hostURL, accountID), nil)
 	if err != nil {
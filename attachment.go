@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"iter"
 	"log"
 	"net/http"
 	"strings"
@@ -12,6 +14,8 @@ import (
 	"github.com/intercloud/autonomi-sdk/models"
 )
 
+var ErrAttachmentSideTaken = errors.New("transport already has an attachment on this side")
+
 func checkAttachmentFinishedTask(ctx context.Context, c *Client, workspaceID, attachmentID string, waiterOptionState models.AdministrativeState) (*models.Attachment, bool) {
 	attachment, err := c.GetAttachment(ctx, workspaceID, attachmentID)
 	if err != nil {
@@ -48,6 +52,12 @@ func (c *Client) CreateAttachment(ctx context.Context, payload models.CreateAtta
 		o(attachmentOptions)
 	}
 
+	if payload.Side != "" {
+		if err := c.checkAttachmentSideAvailable(ctx, workspaceID, payload.TransportID, payload.Side); err != nil {
+			return nil, err
+		}
+	}
+
 	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/accounts/%s/workspaces/%s/attachments", c.hostURL, c.accountID, workspaceID), body)
 	if err != nil {
 		return nil, err
@@ -102,6 +112,46 @@ func (c *Client) GetAttachment(ctx context.Context, workspaceID, attachmentID st
 	return &attachment.Data, err
 }
 
+// ListAttachments lists all the attachments of a workspace, fetching every page.
+func (c *Client) ListAttachments(ctx context.Context, workspaceID string, options ...ListOption) ([]models.Attachment, error) {
+	return Collect(c.AllAttachments(ctx, workspaceID, options...), 0)
+}
+
+// AllAttachments returns an iterator over the attachments of a workspace which lazily fetches the pages.
+func (c *Client) AllAttachments(ctx context.Context, workspaceID string, options ...ListOption) iter.Seq2[models.Attachment, error] {
+	return paginate(ctx, c, fmt.Sprintf("%s/accounts/%s/workspaces/%s/attachments", c.hostURL, c.accountID, workspaceID), options, func(resp []byte) ([]models.Attachment, *models.Pagination, error) {
+		attachments := models.AttachmentsResponse{}
+		if err := json.Unmarshal(resp, &attachments); err != nil {
+			return nil, nil, err
+		}
+
+		return attachments.Data, attachments.Meta, nil
+	})
+}
+
+// checkAttachmentSideAvailable makes sure no other attachment of the transport uses the given side.
+// Attachments being deleted are not considered.
+func (c *Client) checkAttachmentSideAvailable(ctx context.Context, workspaceID, transportID string, side models.AttachmentSide) error {
+	attachments, err := c.ListAttachments(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		if attachment.TransportID != transportID || attachment.Side != side {
+			continue
+		}
+
+		if attachment.State.IsDeleting() {
+			continue
+		}
+
+		return fmt.Errorf("%w: side %s of transport %s is used by attachment %s", ErrAttachmentSideTaken, side, transportID, attachment.ID)
+	}
+
+	return nil
+}
+
 // DeleteAttachment deletes asynchronously an attachment. The attachment returned will depend of the option passed.
 // If none is passed the attachment will be returned once the request accepted, its state will be delete_pending
 // If the option WithWaitUntilElementUndeployed() is passed, the attachment won't be returned as it would have been deleted. However, if an error is triggered, an object could be returned with a delete_error state.
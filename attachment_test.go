@@ -696,6 +696,44 @@ func TestGetAttachmentNotFound(t *testing.T) {
 	g.Expect(data).Should(BeNil())
 }
 
+func TestListAttachmentsSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	result := models.AttachmentsResponse{
+		Data: []models.Attachment{attachmentDeployedResponse.Data},
+	}
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/attachments", accountId, workspaceID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, result),
+		),
+	)
+
+	data, err := cli.ListAttachments(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal(result.Data))
+}
+
+func TestListAttachmentsForbidden(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/attachments", accountId, workspaceID)),
+			gh.RespondWithJSONEncoded(http.StatusForbidden, nil),
+		),
+	)
+
+	data, err := cli.ListAttachments(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
+
 func TestDeleteAttachmentSuccessfully(t *testing.T) {
 	g := NewWithT(t)
 	gh := ghttp.NewGHTTPWithGomega(g)
@@ -993,3 +1031,84 @@ func TestDeleteAttachmentForbidden(t *testing.T) {
 	g.Expect(err).ShouldNot(BeNil())
 	g.Expect(data).Should(BeNil())
 }
+
+func TestCreateAttachmentWithSideSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	// the A side is used by an attachment being deleted, the Z side by another transport
+	beingDeleted := attachmentDeletePendingResponse.Data
+	beingDeleted.Side = models.AttachmentSideA
+	otherTransport := attachmentDeployedResponse.Data
+	otherTransport.TransportID = uuid.NewString()
+
+	payload := models.CreateAttachment{
+		NodeID:      nodeID.String(),
+		TransportID: transportID.String(),
+		Side:        models.AttachmentSideA,
+	}
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/attachments", accountId, workspaceID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.AttachmentsResponse{
+				Data: []models.Attachment{beingDeleted, otherTransport},
+			}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPost, fmt.Sprintf("/accounts/%s/workspaces/%s/attachments", accountId, workspaceID)),
+			gh.VerifyJSONRepresenting(payload),
+			gh.RespondWithJSONEncoded(http.StatusAccepted, attachmentCreateResponse),
+		),
+	)
+
+	data, err := cli.CreateAttachment(context.Background(), payload, workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data.Side).Should(Equal(models.AttachmentSideA))
+}
+
+func TestCreateAttachmentSideTaken(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/attachments", accountId, workspaceID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.AttachmentsResponse{
+				Data: []models.Attachment{attachmentDeployedResponse.Data},
+			}),
+		),
+	)
+
+	data, err := cli.CreateAttachment(
+		context.Background(),
+		models.CreateAttachment{
+			NodeID:      uuid.NewString(),
+			TransportID: transportID.String(),
+			Side:        models.AttachmentSideA,
+		},
+		workspaceID,
+	)
+
+	g.Expect(err).Should(MatchError(ErrAttachmentSideTaken))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestCreateAttachmentInvalidSide(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.CreateAttachment(
+		context.Background(),
+		models.CreateAttachment{
+			NodeID:      nodeID.String(),
+			TransportID: transportID.String(),
+			Side:        "B",
+		},
+		workspaceID,
+	)
+
+	g.Expect(err.Error()).Should(Equal("Key: 'CreateAttachment.Side' Error:Field validation for 'Side' failed on the 'oneof' tag"))
+	g.Expect(data).Should(BeNil())
+}
@@ -62,6 +62,15 @@ func WithPersonalAccessToken(token string) OptionClient {
 	}
 }
 
+// WithPolling sets how often and how many times the state of an element is polled
+// while waiting for an asynchronous operation, every 20 seconds up to 30 times by default.
+func WithPolling(retryInterval time.Duration, maxRetry int) OptionClient {
+	return func(a *Client) {
+		a.poll.retryInterval = retryInterval
+		a.poll.maxRetry = maxRetry
+	}
+}
+
 func initClient(opts ...OptionClient) *Client {
 	client := &Client{
 		httpClient: &http.Client{},
@@ -11,6 +11,8 @@ type elementOptions struct {
 	waitUntilElementDeployed   bool
 	waitUntilElementUndeployed bool
 	administrativeState        models.AdministrativeState
+	aSidePhysicalPortID        string
+	zSidePhysicalPortID        string
 }
 type OptionElement func(*elementOptions)
 
@@ -33,6 +35,15 @@ func WithAdministrativeState(administrativeState models.AdministrativeState) Opt
 	}
 }
 
+// WithPhysicalPorts sets the physical ports reached by each side of a transport. The VLANs of the transport
+// are then checked against the VLANs already used on these ports. An empty port ID skips the check of its side.
+func WithPhysicalPorts(aSidePhysicalPortID, zSidePhysicalPortID string) OptionElement {
+	return func(e *elementOptions) {
+		e.aSidePhysicalPortID = aSidePhysicalPortID
+		e.zSidePhysicalPortID = zSidePhysicalPortID
+	}
+}
+
 type Element interface {
 	*models.Node | *models.Transport | *models.Attachment
 
@@ -48,7 +59,7 @@ func WaitUntilFinishedTask[T Element](ctx context.Context, client *Client, works
 		if finishedTask {
 			return lastElement, true
 		}
-		if lastElement != nil && (lastElement.GetState() == models.AdministrativeStateCreationError || lastElement.GetState() == models.AdministrativeStateDeleteError) {
+		if lastElement != nil && (lastElement.GetState() == models.AdministrativeStateCreationError || lastElement.GetState() == models.AdministrativeStateUpdateError || lastElement.GetState() == models.AdministrativeStateDeleteError) {
 			return lastElement, false
 		}
 		time.Sleep(client.poll.retryInterval)
@@ -0,0 +1,101 @@
+package autonomisdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+var (
+	ErrInvalidElementKind = errors.New("element kind must be one of node, transport or attachment")
+	ErrInvalidTimeRange   = errors.New("start of the time range must be before its end")
+)
+
+// GetElementEvents retrieves the history of the administrative states of an element,
+// ordered from the oldest transition to the most recent one.
+func (c *Client) GetElementEvents(ctx context.Context, workspaceID string, elementKind models.ElementKind, elementID string) ([]models.ElementEvent, error) {
+	if !elementKind.IsValid() {
+		return nil, ErrInvalidElementKind
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/accounts/%s/workspaces/%s/%ss/%s/events", c.hostURL, c.accountID, workspaceID, elementKind, elementID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	events := models.ElementEventsResponse{}
+	if err = json.Unmarshal(resp, &events); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(events.Data, func(i, j int) bool {
+		return events.Data[i].OccurredAt.Before(events.Data[j].OccurredAt)
+	})
+
+	return events.Data, nil
+}
+
+// ListAuditEvents lists all the audit events of the account matching the filter, fetching every page.
+func (c *Client) ListAuditEvents(ctx context.Context, filter models.AuditEventFilter, options ...ListOption) ([]models.AuditEvent, error) {
+	return Collect(c.AllAuditEvents(ctx, filter, options...), 0)
+}
+
+// AllAuditEvents returns an iterator over the audit events of the account matching the filter
+// which lazily fetches the pages.
+func (c *Client) AllAuditEvents(ctx context.Context, filter models.AuditEventFilter, options ...ListOption) iter.Seq2[models.AuditEvent, error] {
+	if err := c.validateAuditEventFilter(ctx, filter); err != nil {
+		return func(yield func(models.AuditEvent, error) bool) {
+			yield(models.AuditEvent{}, err)
+		}
+	}
+
+	q := url.Values{}
+	if filter.UserID != "" {
+		q.Set("userId", filter.UserID)
+	}
+	if !filter.From.IsZero() {
+		q.Set("from", filter.From.UTC().Format(time.RFC3339))
+	}
+	if !filter.To.IsZero() {
+		q.Set("to", filter.To.UTC().Format(time.RFC3339))
+	}
+
+	rawURL := fmt.Sprintf("%s/accounts/%s/events", c.hostURL, c.accountID)
+	if len(q) > 0 {
+		rawURL += "?" + q.Encode()
+	}
+
+	return paginate(ctx, c, rawURL, options, func(resp []byte) ([]models.AuditEvent, *models.Pagination, error) {
+		events := models.AuditEventsResponse{}
+		if err := json.Unmarshal(resp, &events); err != nil {
+			return nil, nil, err
+		}
+
+		return events.Data, events.Meta, nil
+	})
+}
+
+func (c *Client) validateAuditEventFilter(ctx context.Context, filter models.AuditEventFilter) error {
+	if errV := c.validate.StructCtx(ctx, filter); errV != nil {
+		return errV
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return ErrInvalidTimeRange
+	}
+
+	return nil
+}
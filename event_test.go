@@ -0,0 +1,168 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var (
+	eventsStart = time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	transportEventsResponse = models.ElementEventsResponse{
+		Data: []models.ElementEvent{
+			{
+				ID:          uuid.New(),
+				ElementID:   transportID.String(),
+				ElementKind: models.ElementKindTransport,
+				FromState:   models.AdministrativeStateDeletePending,
+				ToState:     models.AdministrativeStateDeleteError,
+				Actor: models.Actor{
+					Type: models.ActorTypePlatform,
+				},
+				OccurredAt: eventsStart.Add(2 * time.Minute),
+				Error: &models.SupportError{
+					Code: "ERR_INTERNAL",
+					Msg:  "an internal error occured",
+				},
+			},
+			{
+				ID:          uuid.New(),
+				ElementID:   transportID.String(),
+				ElementKind: models.ElementKindTransport,
+				FromState:   models.AdministrativeStateDeployed,
+				ToState:     models.AdministrativeStateDeletePending,
+				Actor: models.Actor{
+					ID:   userId,
+					Type: models.ActorTypeUser,
+					Name: "name",
+				},
+				OccurredAt: eventsStart,
+			},
+		},
+	}
+
+	auditEventsResponse = models.AuditEventsResponse{
+		Data: []models.AuditEvent{
+			{
+				ID:           uuid.New(),
+				AccountID:    uuid.MustParse(accountId),
+				WorkspaceID:  workspaceID,
+				Actor:        models.Actor{ID: userId, Type: models.ActorTypeUser},
+				Action:       "delete",
+				ResourceType: "transport",
+				ResourceID:   transportID.String(),
+				OccurredAt:   eventsStart,
+			},
+		},
+	}
+)
+
+func TestGetElementEventsSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/transports/%s/events", accountId, workspaceID, transportID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, transportEventsResponse),
+		),
+	)
+
+	data, err := cli.GetElementEvents(context.Background(), workspaceID, models.ElementKindTransport, transportID.String())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(HaveLen(2))
+
+	// events are ordered from the oldest to the most recent
+	g.Expect(data[0].ToState).Should(Equal(models.AdministrativeStateDeletePending))
+	g.Expect(data[1].ToState).Should(Equal(models.AdministrativeStateDeleteError))
+	g.Expect(data[1].Error.Code).Should(Equal("ERR_INTERNAL"))
+}
+
+func TestGetElementEventsInvalidKind(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.GetElementEvents(context.Background(), workspaceID, "port", transportID.String())
+
+	g.Expect(err).Should(Equal(ErrInvalidElementKind))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestGetElementEventsNotFound(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s/events", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusNotFound, nil),
+		),
+	)
+
+	data, err := cli.GetElementEvents(context.Background(), workspaceID, models.ElementKindNode, nodeID.String())
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
+
+func TestListAuditEventsSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	result := auditEventsResponse
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/events", accountId), "from=2024-03-01T10%3A00%3A00Z&pageSize=100&to=2024-03-02T10%3A00%3A00Z&userId="+userId.String()),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, auditEventsResponse),
+		),
+	)
+
+	data, err := cli.ListAuditEvents(
+		context.Background(),
+		models.AuditEventFilter{
+			UserID: userId.String(),
+			From:   eventsStart,
+			To:     eventsStart.Add(24 * time.Hour),
+		},
+	)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal(result.Data))
+}
+
+func TestListAuditEventsInvalidFilter(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.ListAuditEvents(
+		context.Background(),
+		models.AuditEventFilter{
+			From: eventsStart,
+			To:   eventsStart.Add(-time.Hour),
+		},
+	)
+
+	g.Expect(err).Should(Equal(ErrInvalidTimeRange))
+	g.Expect(data).Should(BeNil())
+
+	data, err = cli.ListAuditEvents(
+		context.Background(),
+		models.AuditEventFilter{
+			UserID: "not-a-uuid",
+		},
+	)
+
+	g.Expect(err.Error()).Should(Equal("Key: 'AuditEventFilter.UserID' Error:Field validation for 'UserID' failed on the 'uuid' tag"))
+	g.Expect(data).Should(BeNil())
+}
@@ -0,0 +1,208 @@
+package autonomisdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// InventoryFailure reports a kind of element which could not be listed.
+type InventoryFailure struct {
+	Resource string
+	Err      error
+}
+
+func (f InventoryFailure) Error() string {
+	return fmt.Sprintf("cannot list %s: %s", f.Resource, f.Err)
+}
+
+func (f InventoryFailure) Unwrap() error {
+	return f.Err
+}
+
+// serverClock records the earliest and the latest Date headers of the responses received.
+type serverClock struct {
+	mu       sync.Mutex
+	earliest time.Time
+	latest   time.Time
+}
+
+func (sc *serverClock) observe(header http.Header) {
+	date, err := http.ParseTime(header.Get("Date"))
+	if err != nil {
+		return
+	}
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if sc.earliest.IsZero() || date.Before(sc.earliest) {
+		sc.earliest = date
+	}
+	if date.After(sc.latest) {
+		sc.latest = date
+	}
+}
+
+// serverClockTransport observes the Date header of the responses going through the wrapped transport.
+type serverClockTransport struct {
+	next  http.RoundTripper
+	clock *serverClock
+}
+
+func (t *serverClockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err == nil {
+		t.clock.observe(res.Header)
+	}
+
+	return res, err
+}
+
+// withServerClock returns a copy of the client whose requests are observed by the clock.
+func (c *Client) withServerClock(clock *serverClock) *Client {
+	next := c.httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	httpClient := *c.httpClient
+	httpClient.Transport = &serverClockTransport{next: next, clock: clock}
+	observed := *c
+	observed.httpClient = &httpClient
+
+	return &observed
+}
+
+// WorkspaceInventory is a snapshot of a workspace and of all its elements.
+type WorkspaceInventory struct {
+	Workspace   models.Workspace
+	Nodes       []models.Node
+	Transports  []models.Transport
+	Attachments []models.Attachment
+
+	// SnapshotAt is the time at which the fetch started, CompletedAt the time at which it ended. Both are
+	// read on the server clock, from the Date header of the responses, and fall back to the client clock
+	// when the server does not send it. The Date header being precise to the second, SnapshotAt is
+	// rounded down to the second.
+	SnapshotAt  time.Time
+	CompletedAt time.Time
+
+	// Failures lists the elements which could not be fetched. The inventory is partial when not empty.
+	Failures []InventoryFailure
+}
+
+// Err returns the failures of the inventory joined in a single error, or nil if the inventory is complete.
+func (wi *WorkspaceInventory) Err() error {
+	errs := make([]error, 0, len(wi.Failures))
+	for _, failure := range wi.Failures {
+		errs = append(errs, failure)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Consistent reports whether the inventory is complete and none of its elements
+// was modified while the snapshot was being taken. The update times of the elements are compared
+// to SnapshotAt, hence to the server clock unless the server did not send any Date header.
+func (wi *WorkspaceInventory) Consistent() bool {
+	if len(wi.Failures) > 0 {
+		return false
+	}
+
+	updatedAt := []time.Time{wi.Workspace.UpdatedAt}
+	for _, node := range wi.Nodes {
+		updatedAt = append(updatedAt, node.UpdatedAt)
+	}
+	for _, transport := range wi.Transports {
+		updatedAt = append(updatedAt, transport.UpdatedAt)
+	}
+	for _, attachment := range wi.Attachments {
+		updatedAt = append(updatedAt, attachment.UpdatedAt)
+	}
+
+	for _, t := range updatedAt {
+		if t.After(wi.SnapshotAt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetWorkspaceInventory fetches concurrently a workspace with all its nodes, transports and attachments.
+// An error is returned only if the workspace itself cannot be fetched, failures on elements are
+// reported in the Failures field of the inventory.
+func (c *Client) GetWorkspaceInventory(ctx context.Context, workspaceID string) (*WorkspaceInventory, error) {
+	inventory := &WorkspaceInventory{
+		SnapshotAt: time.Now(),
+	}
+
+	// the server clock is used to compare the snapshot time with the update times of the elements
+	clock := &serverClock{}
+	observed := c.withServerClock(clock)
+
+	var (
+		wg           sync.WaitGroup
+		mu           sync.Mutex
+		workspace    *models.Workspace
+		workspaceErr error
+	)
+
+	addFailure := func(resource string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		inventory.Failures = append(inventory.Failures, InventoryFailure{Resource: resource, Err: err})
+	}
+
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		workspace, workspaceErr = observed.GetWorkspace(ctx, workspaceID)
+	}()
+	go func() {
+		defer wg.Done()
+		nodes, err := observed.ListNodes(ctx, workspaceID)
+		if err != nil {
+			addFailure("nodes", err)
+			return
+		}
+		inventory.Nodes = nodes
+	}()
+	go func() {
+		defer wg.Done()
+		transports, err := observed.ListTransports(ctx, workspaceID)
+		if err != nil {
+			addFailure("transports", err)
+			return
+		}
+		inventory.Transports = transports
+	}()
+	go func() {
+		defer wg.Done()
+		attachments, err := observed.ListAttachments(ctx, workspaceID)
+		if err != nil {
+			addFailure("attachments", err)
+			return
+		}
+		inventory.Attachments = attachments
+	}()
+	wg.Wait()
+
+	if workspaceErr != nil {
+		return nil, workspaceErr
+	}
+
+	inventory.Workspace = *workspace
+	inventory.CompletedAt = time.Now()
+	if !clock.earliest.IsZero() {
+		inventory.SnapshotAt = clock.earliest
+		inventory.CompletedAt = clock.latest
+	}
+
+	return inventory, nil
+}
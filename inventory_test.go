@@ -0,0 +1,121 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+// routeWorkspaceInventory routes the requests sent by GetWorkspaceInventory. Routes are used
+// instead of ordered handlers as the requests are sent concurrently.
+func routeWorkspaceInventory(transportsStatus int) {
+	server.RouteToHandler(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s", accountId, workspaceID),
+		gh.RespondWithJSONEncoded(http.StatusOK, workspaceCreateResponse),
+	)
+	server.RouteToHandler(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes", accountId, workspaceID),
+		gh.RespondWithJSONEncoded(http.StatusOK, models.NodesResponse{
+			Data: []models.Node{nodeDeployedResponse.Data},
+		}),
+	)
+	server.RouteToHandler(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/transports", accountId, workspaceID),
+		gh.RespondWithJSONEncoded(transportsStatus, models.TransportsResponse{
+			Data: []models.Transport{transportDeployedResponse.Data},
+		}),
+	)
+	server.RouteToHandler(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/attachments", accountId, workspaceID),
+		gh.RespondWithJSONEncoded(http.StatusOK, models.AttachmentsResponse{
+			Data: []models.Attachment{attachmentDeployedResponse.Data},
+		}),
+	)
+}
+
+func TestGetWorkspaceInventorySuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	routeWorkspaceInventory(http.StatusOK)
+
+	before := time.Now()
+	data, err := cli.GetWorkspaceInventory(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data.Workspace).Should(Equal(workspaceCreateResponse.Data))
+	g.Expect(data.Nodes).Should(Equal([]models.Node{nodeDeployedResponse.Data}))
+	g.Expect(data.Transports).Should(Equal([]models.Transport{transportDeployedResponse.Data}))
+	g.Expect(data.Attachments).Should(Equal([]models.Attachment{attachmentDeployedResponse.Data}))
+	// the snapshot time is read from the Date header, precise to the second
+	g.Expect(data.SnapshotAt).Should(BeTemporally(">=", before.Truncate(time.Second)))
+	g.Expect(data.CompletedAt).Should(BeTemporally(">=", data.SnapshotAt))
+	g.Expect(data.Failures).Should(BeEmpty())
+	g.Expect(data.Err()).ShouldNot(HaveOccurred())
+	g.Expect(data.Consistent()).Should(BeTrue())
+}
+
+func TestGetWorkspaceInventoryUsesServerClock(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	// the server clock is one hour ahead of the client one, the node was updated before the snapshot
+	serverNow := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	node := nodeDeployedResponse.Data
+	node.UpdatedAt = serverNow.Add(-time.Minute)
+
+	dated := func(body any) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Date", serverNow.Format(http.TimeFormat))
+			},
+			gh.RespondWithJSONEncoded(http.StatusOK, body),
+		)
+	}
+	base := fmt.Sprintf("/accounts/%s/workspaces/%s", accountId, workspaceID)
+	server.RouteToHandler(http.MethodGet, base, dated(workspaceCreateResponse))
+	server.RouteToHandler(http.MethodGet, base+"/nodes", dated(models.NodesResponse{Data: []models.Node{node}}))
+	server.RouteToHandler(http.MethodGet, base+"/transports", dated(models.TransportsResponse{}))
+	server.RouteToHandler(http.MethodGet, base+"/attachments", dated(models.AttachmentsResponse{}))
+
+	data, err := cli.GetWorkspaceInventory(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data.SnapshotAt).Should(BeTemporally("==", serverNow))
+	g.Expect(data.CompletedAt).Should(BeTemporally("==", serverNow))
+	g.Expect(data.Consistent()).Should(BeTrue())
+}
+
+func TestGetWorkspaceInventoryPartialFailure(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	routeWorkspaceInventory(http.StatusInternalServerError)
+
+	data, err := cli.GetWorkspaceInventory(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data.Nodes).Should(HaveLen(1))
+	g.Expect(data.Transports).Should(BeNil())
+	g.Expect(data.Failures).Should(HaveLen(1))
+	g.Expect(data.Failures[0].Resource).Should(Equal("transports"))
+	g.Expect(data.Err()).Should(MatchError(ContainSubstring("cannot list transports: status: 500")))
+	g.Expect(data.Consistent()).Should(BeFalse())
+}
+
+func TestGetWorkspaceInventoryWorkspaceNotFound(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	routeWorkspaceInventory(http.StatusOK)
+	server.RouteToHandler(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s", accountId, workspaceID),
+		gh.RespondWithJSONEncoded(http.StatusNotFound, nil),
+	)
+
+	data, err := cli.GetWorkspaceInventory(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
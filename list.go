@@ -0,0 +1,224 @@
+package autonomisdk
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+type SortField string
+
+const (
+	SortFieldName      SortField = "name"
+	SortFieldCreatedAt SortField = "createdAt"
+	SortFieldUpdatedAt SortField = "updatedAt"
+	SortFieldState     SortField = "state"
+)
+
+func (sf SortField) String() string {
+	return string(sf)
+}
+
+type SortDirection string
+
+const (
+	SortAscending  SortDirection = "asc"
+	SortDescending SortDirection = "desc"
+)
+
+func (sd SortDirection) String() string {
+	return string(sd)
+}
+
+var ErrInvalidListOption = errors.New("invalid list option")
+
+type listOptions struct {
+	states                []models.AdministrativeState
+	provider              models.ProviderType
+	location              string
+	minAvailableBandwidth int
+	namePrefix            string
+	createdAfter          time.Time
+	createdBefore         time.Time
+	sortField             SortField
+	sortDirection         SortDirection
+	fields                []string
+	limit                 int
+	pageSize              int
+}
+
+// ListOption allows filtering, sorting and limiting the results of a list call.
+type ListOption func(*listOptions)
+
+// FilterByState keeps only the resources in one of the given administrative states.
+func FilterByState(states ...models.AdministrativeState) ListOption {
+	return func(l *listOptions) {
+		l.states = append(l.states, states...)
+	}
+}
+
+// FilterByProvider keeps only the resources whose product is sold by the given provider.
+func FilterByProvider(provider models.ProviderType) ListOption {
+	return func(l *listOptions) {
+		l.provider = provider
+	}
+}
+
+// FilterByLocation keeps only the resources whose product is located in the given location.
+func FilterByLocation(location string) ListOption {
+	return func(l *listOptions) {
+		l.location = location
+	}
+}
+
+// FilterByMinAvailableBandwidth keeps only the physical ports with at least the given available bandwidth.
+func FilterByMinAvailableBandwidth(bandwidth int) ListOption {
+	return func(l *listOptions) {
+		l.minAvailableBandwidth = bandwidth
+	}
+}
+
+// FilterByNamePrefix keeps only the resources whose name starts with prefix.
+func FilterByNamePrefix(prefix string) ListOption {
+	return func(l *listOptions) {
+		l.namePrefix = prefix
+	}
+}
+
+// FilterByCreatedAfter keeps only the resources created after t.
+func FilterByCreatedAfter(t time.Time) ListOption {
+	return func(l *listOptions) {
+		l.createdAfter = t
+	}
+}
+
+// FilterByCreatedBefore keeps only the resources created before t.
+func FilterByCreatedBefore(t time.Time) ListOption {
+	return func(l *listOptions) {
+		l.createdBefore = t
+	}
+}
+
+// SortBy sorts the resources on field in the given direction.
+func SortBy(field SortField, direction SortDirection) ListOption {
+	return func(l *listOptions) {
+		l.sortField = field
+		l.sortDirection = direction
+	}
+}
+
+// WithFields restricts the fields returned for each resource. The id is always returned.
+func WithFields(fields ...string) ListOption {
+	return func(l *listOptions) {
+		l.fields = append(l.fields, fields...)
+	}
+}
+
+// WithLimit stops the listing once limit resources have been returned.
+func WithLimit(limit int) ListOption {
+	return func(l *listOptions) {
+		l.limit = limit
+	}
+}
+
+// WithPageSize sets the number of items fetched per request, 100 by default.
+func WithPageSize(pageSize int) ListOption {
+	return func(l *listOptions) {
+		l.pageSize = pageSize
+	}
+}
+
+func newListOptions(options ...ListOption) *listOptions {
+	l := &listOptions{}
+	for _, o := range options {
+		o(l)
+	}
+
+	return l
+}
+
+// validate checks the list options before any request is sent.
+func (l *listOptions) validate() error {
+	for _, state := range l.states {
+		if !state.IsValid() {
+			return fmt.Errorf("%w: unknown administrative state '%s'", ErrInvalidListOption, state)
+		}
+	}
+
+	if l.minAvailableBandwidth < 0 {
+		return fmt.Errorf("%w: minimum available bandwidth must be positive", ErrInvalidListOption)
+	}
+
+	if !l.createdAfter.IsZero() && !l.createdBefore.IsZero() && !l.createdAfter.Before(l.createdBefore) {
+		return fmt.Errorf("%w: created after must be before created before", ErrInvalidListOption)
+	}
+
+	switch l.sortField {
+	case "", SortFieldName, SortFieldCreatedAt, SortFieldUpdatedAt, SortFieldState:
+	default:
+		return fmt.Errorf("%w: unknown sort field '%s'", ErrInvalidListOption, l.sortField)
+	}
+
+	switch l.sortDirection {
+	case "", SortAscending, SortDescending:
+	default:
+		return fmt.Errorf("%w: unknown sort direction '%s'", ErrInvalidListOption, l.sortDirection)
+	}
+
+	for _, field := range l.fields {
+		if strings.TrimSpace(field) == "" {
+			return fmt.Errorf("%w: field names must not be empty", ErrInvalidListOption)
+		}
+	}
+
+	if l.limit < 0 {
+		return fmt.Errorf("%w: limit must be positive", ErrInvalidListOption)
+	}
+
+	if l.pageSize < 0 {
+		return fmt.Errorf("%w: page size must be positive", ErrInvalidListOption)
+	}
+
+	return nil
+}
+
+// encode adds the list options to the query parameters q.
+func (l *listOptions) encode(q url.Values) {
+	for _, state := range l.states {
+		q.Add("state", state.String())
+	}
+	if l.provider != "" {
+		q.Set("provider", l.provider.String())
+	}
+	if l.location != "" {
+		q.Set("location", l.location)
+	}
+	if l.minAvailableBandwidth > 0 {
+		q.Set("minAvailableBandwidth", strconv.Itoa(l.minAvailableBandwidth))
+	}
+	if l.namePrefix != "" {
+		q.Set("namePrefix", l.namePrefix)
+	}
+	if !l.createdAfter.IsZero() {
+		q.Set("createdAfter", l.createdAfter.UTC().Format(time.RFC3339))
+	}
+	if !l.createdBefore.IsZero() {
+		q.Set("createdBefore", l.createdBefore.UTC().Format(time.RFC3339))
+	}
+	if l.sortField != "" {
+		q.Set("sort", l.sortField.String())
+		direction := l.sortDirection
+		if direction == "" {
+			direction = SortAscending
+		}
+		q.Set("order", direction.String())
+	}
+	if len(l.fields) > 0 {
+		q.Set("fields", strings.Join(l.fields, ","))
+	}
+}
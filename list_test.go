@@ -0,0 +1,134 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListOptionsEncode(t *testing.T) {
+	after := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, time.February, 1, 12, 30, 0, 0, time.UTC)
+
+	q := url.Values{}
+	newListOptions(
+		FilterByState(models.AdministrativeStateDeployed, models.AdministrativeStateDeleteError),
+		FilterByNamePrefix("prod-"),
+		FilterByCreatedAfter(after),
+		FilterByCreatedBefore(before),
+		SortBy(SortFieldCreatedAt, SortDescending),
+		WithFields("name", "administrativeState"),
+	).encode(q)
+
+	assert.Equal(t, "createdAfter=2024-01-01T00%3A00%3A00Z&createdBefore=2024-02-01T12%3A30%3A00Z&fields=name%2CadministrativeState&namePrefix=prod-&order=desc&sort=createdAt&state=deployed&state=delete_error", q.Encode())
+}
+
+func TestListOptionsValidate(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		options []ListOption
+		valid   bool
+	}{
+		{
+			name:    "no option",
+			options: nil,
+			valid:   true,
+		},
+		{
+			name:    "all options",
+			options: []ListOption{FilterByState(models.AdministrativeStateDeployed), FilterByCreatedAfter(now.Add(-time.Hour)), FilterByCreatedBefore(now), SortBy(SortFieldName, SortAscending), WithLimit(10), WithPageSize(5)},
+			valid:   true,
+		},
+		{
+			name:    "unknown state",
+			options: []ListOption{FilterByState("running")},
+		},
+		{
+			name:    "created after is after created before",
+			options: []ListOption{FilterByCreatedAfter(now), FilterByCreatedBefore(now.Add(-time.Hour))},
+		},
+		{
+			name:    "unknown sort field",
+			options: []ListOption{SortBy("price", SortAscending)},
+		},
+		{
+			name:    "unknown sort direction",
+			options: []ListOption{SortBy(SortFieldName, "up")},
+		},
+		{
+			name:    "empty field",
+			options: []ListOption{WithFields("name", " ")},
+		},
+		{
+			name:    "negative limit",
+			options: []ListOption{WithLimit(-1)},
+		},
+		{
+			name:    "negative page size",
+			options: []ListOption{WithPageSize(-1)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+		tc := tc
+		err := newListOptions(tc.options...).validate()
+		if tc.valid {
+			assert.NoError(t, err)
+		} else {
+			assert.ErrorIs(t, err, ErrInvalidListOption)
+		}
+	}
+}
+
+func TestListWorkspacesWithOptions(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces", accountId), "namePrefix=prod-&order=asc&pageSize=2&sort=name"),
+			gh.RespondWithJSONEncoded(http.StatusOK, workspacesPage(1, 3, "prod-a", "prod-b")),
+		),
+	)
+
+	data, err := cli.ListWorkspaces(
+		context.Background(),
+		uuid.MustParse(accountId),
+		FilterByNamePrefix("prod-"),
+		SortBy(SortFieldName, ""),
+		WithLimit(2),
+	)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(HaveLen(2))
+	g.Expect(server.ReceivedRequests()).Should(HaveLen(2))
+}
+
+func TestListWorkspacesInvalidOptions(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.ListWorkspaces(
+		context.Background(),
+		uuid.MustParse(accountId),
+		FilterByState("running"),
+	)
+
+	g.Expect(err).Should(MatchError(ErrInvalidListOption))
+	g.Expect(data).Should(BeNil())
+
+	// no request must have been sent apart from the client initialization
+	g.Expect(server.ReceivedRequests()).Should(HaveLen(1))
+}
@@ -0,0 +1,112 @@
+package autonomisdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+const loaContentType = "application/pdf"
+
+var (
+	ErrLOAUnavailable        = errors.New("letter of authorization is not available for this physical port")
+	ErrLOAInvalidContentType = errors.New("letter of authorization is not a pdf document")
+
+	unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+)
+
+// DownloadLOA streams the Letter of Authorization of a physical port into w.
+// It returns the number of bytes written.
+func (c *Client) DownloadLOA(ctx context.Context, portID string, w io.Writer) (int64, error) {
+	port, err := c.GetPhysicalPort(ctx, portID)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.downloadLOA(ctx, port, w)
+}
+
+// SaveLOA downloads the Letter of Authorization of a physical port into the directory dir.
+// The file is named after the port, e.g. "my-port.pdf", and its path is returned.
+func (c *Client) SaveLOA(ctx context.Context, portID, dir string) (string, error) {
+	port, err := c.GetPhysicalPort(ctx, portID)
+	if err != nil {
+		return "", err
+	}
+
+	name := unsafeFileNameChars.ReplaceAllString(port.Name, "_")
+	if name == "" || name == "." || name == ".." {
+		name = port.ID.String()
+	}
+	path := filepath.Join(dir, name+".pdf")
+
+	// write into a temporary file first so that a failed download never leaves a truncated LOA behind
+	tmp, err := os.CreateTemp(dir, name+".*.pdf.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := c.downloadLOA(ctx, port, tmp); err != nil {
+		tmp.Close()
+		return "", err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+func (c *Client) downloadLOA(ctx context.Context, port *models.PhysicalPort, w io.Writer) (int64, error) {
+	if port.LOAAccessURL == "" {
+		return 0, ErrLOAUnavailable
+	}
+
+	// the url may be relative to the Autonomi host
+	loaURL, err := c.hostURL.Parse(port.LOAAccessURL)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, loaURL.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	// the token is only sent to the Autonomi host, an absolute url may point to a pre-signed storage url
+	if loaURL.Scheme == c.hostURL.Scheme && loaURL.Host == c.hostURL.Host {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.personalAccessToken))
+	}
+	req.Header.Add("Accept", loaContentType)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(res.Body)
+		return 0, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if err != nil || mediaType != loaContentType {
+		return 0, ErrLOAInvalidContentType
+	}
+
+	return io.Copy(w, res.Body)
+}
@@ -0,0 +1,153 @@
+package autonomisdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var (
+	loaContent = []byte("%PDF-1.4 letter of authorization")
+
+	portWithLOAResponse = models.PhysicalPortSingleResponse{
+		Data: models.PhysicalPort{
+			BaseModel: models.BaseModel{
+				ID: physicalPortId,
+			},
+			Name:         "paris port/1",
+			LOAAccessURL: fmt.Sprintf("/accounts/%s/ports/%s/loa", accountId, physicalPortId),
+		},
+	}
+)
+
+func appendPortWithLOAHandlers(contentType string) {
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/ports/%s", accountId, physicalPortId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, portWithLOAResponse),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/ports/%s/loa", accountId, physicalPortId)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			ghttp.RespondWith(http.StatusOK, loaContent, http.Header{"Content-Type": []string{contentType}}),
+		),
+	)
+}
+
+func TestDownloadLOASuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	appendPortWithLOAHandlers("application/pdf")
+
+	buf := new(bytes.Buffer)
+	n, err := cli.DownloadLOA(context.Background(), physicalPortId.String(), buf)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).Should(Equal(int64(len(loaContent))))
+	g.Expect(buf.Bytes()).Should(Equal(loaContent))
+}
+
+func TestDownloadLOAFromAnotherHost(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	storage := ghttp.NewServer()
+	defer storage.Close()
+	storage.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, "/loa.pdf", "signature=abc"),
+			func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.Header.Get("Authorization")).Should(BeEmpty())
+			},
+			ghttp.RespondWith(http.StatusOK, loaContent, http.Header{"Content-Type": []string{"application/pdf"}}),
+		),
+	)
+
+	port := portWithLOAResponse
+	port.Data.LOAAccessURL = storage.URL() + "/loa.pdf?signature=abc"
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/ports/%s", accountId, physicalPortId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, port),
+		),
+	)
+
+	buf := new(bytes.Buffer)
+	_, err := cli.DownloadLOA(context.Background(), physicalPortId.String(), buf)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(buf.Bytes()).Should(Equal(loaContent))
+	g.Expect(storage.ReceivedRequests()).Should(HaveLen(1))
+}
+
+func TestDownloadLOAInvalidContentType(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	appendPortWithLOAHandlers("text/html")
+
+	buf := new(bytes.Buffer)
+	_, err := cli.DownloadLOA(context.Background(), physicalPortId.String(), buf)
+
+	g.Expect(err).Should(MatchError(ErrLOAInvalidContentType))
+	g.Expect(buf.Len()).Should(BeZero())
+}
+
+func TestDownloadLOAUnavailable(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/ports/%s", accountId, physicalPortId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, portCreatedSingleResponse),
+		),
+	)
+
+	_, err := cli.DownloadLOA(context.Background(), physicalPortId.String(), new(bytes.Buffer))
+
+	g.Expect(err).Should(MatchError(ErrLOAUnavailable))
+}
+
+func TestSaveLOASuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	appendPortWithLOAHandlers("application/pdf; charset=binary")
+
+	dir := t.TempDir()
+	path, err := cli.SaveLOA(context.Background(), physicalPortId.String(), dir)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(path).Should(Equal(filepath.Join(dir, "paris_port_1.pdf")))
+
+	content, err := os.ReadFile(path)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(content).Should(Equal(loaContent))
+}
+
+func TestSaveLOAInvalidContentType(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	appendPortWithLOAHandlers("text/html")
+
+	dir := t.TempDir()
+	_, err := cli.SaveLOA(context.Background(), physicalPortId.String(), dir)
+
+	g.Expect(err).Should(MatchError(ErrLOAInvalidContentType))
+
+	// no partial file must be left behind
+	entries, err := os.ReadDir(dir)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(entries).Should(BeEmpty())
+}
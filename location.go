@@ -0,0 +1,58 @@
+package autonomisdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+var ErrCSPRequired = errors.New("cloud service provider name must be set")
+
+// ListLocations lists the locations where products can be ordered.
+func (c *Client) ListLocations(ctx context.Context) ([]models.Location, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/accounts/%s/locations", c.hostURL, c.accountID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := models.LocationsResponse{}
+	if err = json.Unmarshal(resp, &locations); err != nil {
+		return nil, err
+	}
+
+	return locations.Data, nil
+}
+
+// ListCloudRegions lists the regions of the cloud service provider csp (e.g. "AWS") which can be reached.
+func (c *Client) ListCloudRegions(ctx context.Context, csp string) ([]models.CloudRegion, error) {
+	if csp == "" {
+		return nil, ErrCSPRequired
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/accounts/%s/csps/%s/regions", c.hostURL, c.accountID, url.PathEscape(csp)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := models.CloudRegionsResponse{}
+	if err = json.Unmarshal(resp, &regions); err != nil {
+		return nil, err
+	}
+
+	return regions.Data, nil
+}
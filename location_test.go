@@ -0,0 +1,111 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var (
+	locationsResponse = models.LocationsResponse{
+		Data: []models.Location{
+			{
+				ID:                  "equinix-fr5",
+				Name:                "Equinix FR5",
+				Provider:            models.ProviderTypeEquinix,
+				FacilityCode:        "FR5",
+				City:                "Frankfurt",
+				Country:             "DE",
+				SupportedCSPs:       []string{"AWS", "Azure"},
+				AvailableBandwidths: []int{100, 1000},
+			},
+		},
+	}
+
+	cloudRegionsResponse = models.CloudRegionsResponse{
+		Data: []models.CloudRegion{
+			{
+				CSPName:             "AWS",
+				Region:              "eu-central-1",
+				City:                "Frankfurt",
+				Country:             "DE",
+				Locations:           []string{"Equinix FR5"},
+				AvailableBandwidths: []int{100, 1000},
+			},
+		},
+	}
+)
+
+func TestListLocationsSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	result := locationsResponse
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/locations", accountId)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, locationsResponse),
+		),
+	)
+
+	data, err := cli.ListLocations(context.Background())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal(result.Data))
+	g.Expect(data[0].SupportsCSP("azure")).Should(BeTrue())
+	g.Expect(data[0].SupportsCSP("GCP")).Should(BeFalse())
+	g.Expect(data[0].SupportsBandwidth(1000)).Should(BeTrue())
+	g.Expect(data[0].SupportsBandwidth(10000)).Should(BeFalse())
+}
+
+func TestListLocationsForbidden(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/locations", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusForbidden, nil),
+		),
+	)
+
+	data, err := cli.ListLocations(context.Background())
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
+
+func TestListCloudRegionsSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	result := cloudRegionsResponse
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/csps/AWS/regions", accountId)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, cloudRegionsResponse),
+		),
+	)
+
+	data, err := cli.ListCloudRegions(context.Background(), "AWS")
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal(result.Data))
+}
+
+func TestListCloudRegionsMissingCSP(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.ListCloudRegions(context.Background(), "")
+
+	g.Expect(err).Should(Equal(ErrCSPRequired))
+	g.Expect(data).Should(BeNil())
+}
@@ -4,12 +4,23 @@ import (
 	"time"
 )
 
+type AttachmentSide string
+
+const (
+	AttachmentSideA AttachmentSide = "A"
+	AttachmentSideZ AttachmentSide = "Z"
+)
+
+func (as AttachmentSide) String() string {
+	return string(as)
+}
+
 type Attachment struct {
 	BaseModel
 	TransportID string              `json:"transportId"`
 	NodeID      string              `json:"nodeId"`
 	State       AdministrativeState `json:"administrativeState"`
-	Side        string              `json:"side"`
+	Side        AttachmentSide      `json:"side"`
 	DeployedAt  *time.Time          `json:"deployedAt,omitempty"`
 	Error       *SupportError       `json:"error,omitempty"`
 	WorkspaceID string              `json:"workspaceId"`
@@ -19,9 +30,15 @@ type AttachmentResponse struct {
 	Data Attachment `json:"data"`
 }
 
+type AttachmentsResponse struct {
+	Data []Attachment `json:"data"`
+	Meta *Pagination  `json:"meta,omitempty"`
+}
+
 type CreateAttachment struct {
-	NodeID      string `json:"nodeId" binding:"required"`
-	TransportID string `json:"transportId" binding:"required"`
+	NodeID      string         `json:"nodeId" binding:"required"`
+	TransportID string         `json:"transportId" binding:"required"`
+	Side        AttachmentSide `json:"side,omitempty" binding:"omitempty,oneof=A Z"`
 }
 
 func (a *Attachment) GetState() AdministrativeState {
@@ -21,6 +21,9 @@ const (
 	AdministrativeStateCreationError   AdministrativeState = "creation_error"
 	AdministrativeStateCreated         AdministrativeState = "created"
 	AdministrativeStateDeployed        AdministrativeState = "deployed"
+	AdministrativeStateUpdatePending   AdministrativeState = "update_pending"
+	AdministrativeStateUpdateProceed   AdministrativeState = "update_proceed"
+	AdministrativeStateUpdateError     AdministrativeState = "update_error"
 	AdministrativeStateDeletePending   AdministrativeState = "delete_pending"
 	AdministrativeStateDeleteProceed   AdministrativeState = "delete_proceed"
 	AdministrativeStateDeleteError     AdministrativeState = "delete_error"
@@ -31,6 +34,43 @@ func (as AdministrativeState) String() string {
 	return string(as)
 }
 
+// IsValid reports whether the administrative state is known.
+func (as AdministrativeState) IsValid() bool {
+	switch as {
+	case AdministrativeStateCreationPending, AdministrativeStateCreationProceed, AdministrativeStateCreationError,
+		AdministrativeStateCreated, AdministrativeStateDeployed,
+		AdministrativeStateUpdatePending, AdministrativeStateUpdateProceed, AdministrativeStateUpdateError,
+		AdministrativeStateDeletePending, AdministrativeStateDeleteProceed, AdministrativeStateDeleteError,
+		AdministrativeStateDeleted:
+		return true
+	}
+
+	return false
+}
+
+// IsDeleting reports whether the element is being deleted or is already deleted.
+func (as AdministrativeState) IsDeleting() bool {
+	switch as {
+	case AdministrativeStateDeletePending, AdministrativeStateDeleteProceed, AdministrativeStateDeleted:
+		return true
+	}
+
+	return false
+}
+
 type UpdateElement struct {
 	Name string `json:"name"`
 }
+
+// UpdateElementProduct changes in place the product, hence the bandwidth, of a node or a transport.
+type UpdateElementProduct struct {
+	Product AddProduct `json:"product" binding:"required"`
+}
+
+// Pagination describes the page returned by a list endpoint.
+type Pagination struct {
+	Page       int `json:"page"`
+	PageSize   int `json:"pageSize"`
+	TotalItems int `json:"totalItems"`
+	TotalPages int `json:"totalPages"`
+}
@@ -32,6 +32,21 @@ func TestAdministrativeStateString(t *testing.T) {
 			administrativeState: AdministrativeStateDeployed,
 			expect:              "deployed",
 		},
+		{
+			name:                AdministrativeStateUpdatePending.String(),
+			administrativeState: AdministrativeStateUpdatePending,
+			expect:              "update_pending",
+		},
+		{
+			name:                AdministrativeStateUpdateProceed.String(),
+			administrativeState: AdministrativeStateUpdateProceed,
+			expect:              "update_proceed",
+		},
+		{
+			name:                AdministrativeStateUpdateError.String(),
+			administrativeState: AdministrativeStateUpdateError,
+			expect:              "update_error",
+		},
 		{
 			name:                AdministrativeStateDeletePending.String(),
 			administrativeState: AdministrativeStateDeletePending,
@@ -60,3 +75,18 @@ func TestAdministrativeStateString(t *testing.T) {
 		assert.Equal(t, tc.expect, tc.administrativeState.String())
 	}
 }
+
+func TestAdministrativeStateIsValid(t *testing.T) {
+	assert.True(t, AdministrativeStateDeployed.IsValid())
+	assert.True(t, AdministrativeStateDeleteError.IsValid())
+	assert.False(t, AdministrativeState("unknown").IsValid())
+	assert.False(t, AdministrativeState("").IsValid())
+}
+
+func TestAdministrativeStateIsDeleting(t *testing.T) {
+	assert.True(t, AdministrativeStateDeletePending.IsDeleting())
+	assert.True(t, AdministrativeStateDeleteProceed.IsDeleting())
+	assert.True(t, AdministrativeStateDeleted.IsDeleting())
+	assert.False(t, AdministrativeStateDeleteError.IsDeleting())
+	assert.False(t, AdministrativeStateDeployed.IsDeleting())
+}
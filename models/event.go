@@ -0,0 +1,88 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ElementKind string
+
+const (
+	ElementKindNode       ElementKind = "node"
+	ElementKindTransport  ElementKind = "transport"
+	ElementKindAttachment ElementKind = "attachment"
+)
+
+func (ek ElementKind) String() string {
+	return string(ek)
+}
+
+// IsValid reports whether the element kind is known.
+func (ek ElementKind) IsValid() bool {
+	switch ek {
+	case ElementKindNode, ElementKindTransport, ElementKindAttachment:
+		return true
+	}
+
+	return false
+}
+
+type ActorType string
+
+const (
+	ActorTypeUser     ActorType = "user"
+	ActorTypePlatform ActorType = "platform"
+)
+
+func (at ActorType) String() string {
+	return string(at)
+}
+
+// Actor is the author of an event.
+type Actor struct {
+	ID    uuid.UUID `json:"id"`
+	Type  ActorType `json:"type"`
+	Name  string    `json:"name,omitempty"`
+	Email string    `json:"email,omitempty"`
+}
+
+// ElementEvent is a transition of the administrative state of a node, a transport or an attachment.
+type ElementEvent struct {
+	ID          uuid.UUID           `json:"id"`
+	ElementID   string              `json:"elementId"`
+	ElementKind ElementKind         `json:"elementKind"`
+	FromState   AdministrativeState `json:"fromState,omitempty"`
+	ToState     AdministrativeState `json:"toState"`
+	Actor       Actor               `json:"actor"`
+	OccurredAt  time.Time           `json:"occurredAt"`
+	Error       *SupportError       `json:"error,omitempty"`
+}
+
+type ElementEventsResponse struct {
+	Data []ElementEvent `json:"data"`
+}
+
+// AuditEvent is an action performed on the account.
+type AuditEvent struct {
+	ID           uuid.UUID `json:"id"`
+	AccountID    uuid.UUID `json:"accountId"`
+	WorkspaceID  string    `json:"workspaceId,omitempty"`
+	Actor        Actor     `json:"actor"`
+	Action       string    `json:"action"`
+	ResourceType string    `json:"resourceType"`
+	ResourceID   string    `json:"resourceId"`
+	OccurredAt   time.Time `json:"occurredAt"`
+}
+
+type AuditEventsResponse struct {
+	Data []AuditEvent `json:"data"`
+	Meta *Pagination  `json:"meta,omitempty"`
+}
+
+// AuditEventFilter restricts the audit events returned. Zero values are ignored.
+type AuditEventFilter struct {
+	UserID string    `json:"userId,omitempty" binding:"omitempty,uuid"`
+	From   time.Time `json:"from,omitempty"`
+	To     time.Time `json:"to,omitempty"`
+}
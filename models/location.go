@@ -0,0 +1,53 @@
+package models
+
+import "strings"
+
+type Location struct {
+	ID                  string       `json:"id"`
+	Name                string       `json:"name"`
+	Provider            ProviderType `json:"provider"`
+	FacilityCode        string       `json:"facilityCode"`
+	City                string       `json:"city"`
+	Country             string       `json:"country"`
+	SupportedCSPs       []string     `json:"supportedCsps"`
+	AvailableBandwidths []int        `json:"availableBandwidths"`
+}
+
+type LocationsResponse struct {
+	Data []Location `json:"data"`
+}
+
+// SupportsCSP reports whether the cloud service provider csp can be reached from the location.
+func (l *Location) SupportsCSP(csp string) bool {
+	for _, supported := range l.SupportedCSPs {
+		if strings.EqualFold(supported, csp) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SupportsBandwidth reports whether a product of the given bandwidth can be ordered in the location.
+func (l *Location) SupportsBandwidth(bandwidth int) bool {
+	for _, available := range l.AvailableBandwidths {
+		if available == bandwidth {
+			return true
+		}
+	}
+
+	return false
+}
+
+type CloudRegion struct {
+	CSPName             string   `json:"cspName"`
+	Region              string   `json:"region"`
+	City                string   `json:"city"`
+	Country             string   `json:"country"`
+	Locations           []string `json:"locations"`
+	AvailableBandwidths []int    `json:"availableBandwidths"`
+}
+
+type CloudRegionsResponse struct {
+	Data []CloudRegion `json:"data"`
+}
@@ -58,6 +58,16 @@ type ServiceKey struct {
 	Name           string    `json:"name,omitempty"`
 }
 
+// ExpiresBefore reports whether the service key is no longer valid at t.
+// A key without expiration date never expires.
+func (sk *ServiceKey) ExpiresBefore(t time.Time) bool {
+	return !sk.ExpirationDate.IsZero() && sk.ExpirationDate.Before(t)
+}
+
+type ServiceKeyResponse struct {
+	Data ServiceKey `json:"data"`
+}
+
 type Node struct {
 	BaseModel
 	WorkspaceID    string               `json:"workspaceId"`
@@ -74,6 +84,7 @@ type Node struct {
 	Error          *SupportError        `json:"error,omitempty"`
 	PhysicalPort   *PhysicalPort        `json:"physicalPort,omitempty"`
 	ServiceKey     *ServiceKey          `json:"serviceKey,omitempty"`
+	RouterConfig   *RouterConfig        `json:"routerConfig,omitempty"`
 }
 
 func (n *Node) GetState() AdministrativeState {
@@ -84,6 +95,11 @@ type NodeResponse struct {
 	Data Node `json:"data"`
 }
 
+type NodesResponse struct {
+	Data []Node      `json:"data"`
+	Meta *Pagination `json:"meta,omitempty"`
+}
+
 type AddProduct struct {
 	SKU string `json:"sku" binding:"required"`
 }
@@ -94,4 +110,5 @@ type CreateNode struct {
 	ProviderConfig *ProviderCloudConfig `json:"providerConfig" binding:"required_if=Type cloud"`
 	PhysicalPortID *uuid.UUID           `json:"physicalPortId,omitempty"`
 	Vlan           int64                `json:"vlan,omitempty"`
+	RouterConfig   *RouterConfig        `json:"routerConfig,omitempty"`
 }
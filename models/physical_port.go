@@ -21,9 +21,14 @@ type PhysicalPortSingleResponse struct {
 
 type PhysicalPortListResponse struct {
 	Data []PhysicalPort `json:"data"`
+	Meta *Pagination    `json:"meta,omitempty"`
 }
 
 type CreatePhysicalPort struct {
 	Name    string     `json:"name" binding:"required"`
 	Product AddProduct `json:"product" binding:"required"`
 }
+
+type UpdatePhysicalPort struct {
+	Name string `json:"name" binding:"required"`
+}
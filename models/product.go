@@ -26,3 +26,72 @@ type Product struct {
 	CostMRC   int          `json:"costMrc"`
 	SKU       string       `json:"sku"`
 }
+
+type ProductFamily string
+
+const (
+	ProductFamilyNode      ProductFamily = "node"
+	ProductFamilyTransport ProductFamily = "transport"
+	ProductFamilyPort      ProductFamily = "port"
+)
+
+func (pf ProductFamily) String() string {
+	return string(pf)
+}
+
+// ProductFilter restricts the products returned by the catalog. Zero values are ignored.
+type ProductFilter struct {
+	Family     ProductFamily     `json:"family,omitempty" binding:"omitempty,oneof=node transport port"`
+	SKU        string            `json:"sku,omitempty"`
+	Provider   ProviderType      `json:"provider,omitempty"`
+	Location   string            `json:"location,omitempty"`
+	LocationTo string            `json:"locationTo,omitempty"`
+	CSPName    string            `json:"cspName,omitempty"`
+	CSPRegion  string            `json:"cspRegion,omitempty"`
+	Bandwidth  int               `json:"bandwidth,omitempty" binding:"omitempty,min=1"`
+	Type       AccessProductType `json:"type,omitempty" binding:"omitempty,oneof=PHYSICAL VIRTUAL"`
+}
+
+// ProductCatalog lists the products which can be ordered, grouped by family.
+type ProductCatalog struct {
+	Nodes      []NodeProduct         `json:"nodes"`
+	Transports []TransportProduct    `json:"transports"`
+	Ports      []PhysicalPortProduct `json:"ports"`
+}
+
+type ProductCatalogResponse struct {
+	Data ProductCatalog `json:"data"`
+}
+
+// NodeProduct returns the node product identified by sku.
+func (pc *ProductCatalog) NodeProduct(sku string) (*NodeProduct, bool) {
+	for i := range pc.Nodes {
+		if pc.Nodes[i].SKU == sku {
+			return &pc.Nodes[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// TransportProduct returns the transport product identified by sku.
+func (pc *ProductCatalog) TransportProduct(sku string) (*TransportProduct, bool) {
+	for i := range pc.Transports {
+		if pc.Transports[i].SKU == sku {
+			return &pc.Transports[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// PortProduct returns the physical port product identified by sku.
+func (pc *ProductCatalog) PortProduct(sku string) (*PhysicalPortProduct, bool) {
+	for i := range pc.Ports {
+		if pc.Ports[i].SKU == sku {
+			return &pc.Ports[i], true
+		}
+	}
+
+	return nil, false
+}
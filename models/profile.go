@@ -0,0 +1,90 @@
+package models
+
+import "github.com/google/uuid"
+
+type PermissionAction string
+
+const (
+	PermissionActionRead   PermissionAction = "read"
+	PermissionActionWrite  PermissionAction = "write"
+	PermissionActionDelete PermissionAction = "delete"
+)
+
+func (pa PermissionAction) String() string {
+	return string(pa)
+}
+
+const (
+	PermissionResourceAll          = "*"
+	PermissionResourceAccount      = "account"
+	PermissionResourceUser         = "user"
+	PermissionResourceWorkspace    = "workspace"
+	PermissionResourceNode         = "node"
+	PermissionResourceTransport    = "transport"
+	PermissionResourceAttachment   = "attachment"
+	PermissionResourcePhysicalPort = "port"
+)
+
+// Permission grants actions on a kind of resource, or on all of them with the resource "*".
+type Permission struct {
+	Resource string             `json:"resource"`
+	Actions  []PermissionAction `json:"actions"`
+}
+
+type Profile struct {
+	BaseModel
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// Can reports whether the profile grants action on resource.
+func (p *Profile) Can(resource string, action PermissionAction) bool {
+	return can(p.Permissions, resource, action)
+}
+
+func can(permissions []Permission, resource string, action PermissionAction) bool {
+	for _, permission := range permissions {
+		if permission.Resource != resource && permission.Resource != PermissionResourceAll {
+			continue
+		}
+		for _, allowed := range permission.Actions {
+			if allowed == action {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type ProfileResponse struct {
+	Data Profile `json:"data"`
+}
+
+type ProfilesResponse struct {
+	Data []Profile   `json:"data"`
+	Meta *Pagination `json:"meta,omitempty"`
+}
+
+// WorkspaceRole grants the permissions of a profile to a user on a workspace.
+type WorkspaceRole struct {
+	BaseModel
+	WorkspaceID string    `json:"workspaceId"`
+	UserID      uuid.UUID `json:"userId"`
+	ProfileID   uuid.UUID `json:"profileId"`
+}
+
+type WorkspaceRoleResponse struct {
+	Data WorkspaceRole `json:"data"`
+}
+
+type WorkspaceRolesResponse struct {
+	Data []WorkspaceRole `json:"data"`
+	Meta *Pagination     `json:"meta,omitempty"`
+}
+
+type AssignWorkspaceRole struct {
+	UserID    string `json:"userId" binding:"required,uuid"`
+	ProfileID string `json:"profileId" binding:"required,uuid"`
+}
@@ -0,0 +1,131 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	CSPNameAWS    = "AWS"
+	CSPNameAzure  = "Azure"
+	CSPNameGoogle = "GCP"
+	CSPNameOracle = "Oracle"
+)
+
+var (
+	ErrInvalidAWSAccountID     = errors.New("aws account id must be made of 12 digits")
+	ErrInvalidAzureServiceKey  = errors.New("azure expressroute service key must be a uuid")
+	ErrInvalidGooglePairingKey = errors.New("gcp pairing key must be formatted as <uuid>/<region>/<1|2>")
+	ErrInvalidOracleOCID       = errors.New("oracle virtual circuit id must be a valid ocid")
+	ErrCSPMismatch             = errors.New("provider configuration does not match the product cloud service provider")
+
+	awsAccountIDRegexp     = regexp.MustCompile(`^[0-9]{12}$`)
+	azureServiceKeyRegexp  = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	googlePairingKeyRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}/[a-z]+-[a-z]+[0-9]+/[12]$`)
+	oracleOCIDRegexp       = regexp.MustCompile(`^ocid1\.[a-z0-9]+\.[a-z0-9]+\.[a-z0-9-]*(\.[a-z0-9-]+)?\.[a-z0-9]+$`)
+
+	// cspAliases lists the names under which a cloud service provider can appear in a product.
+	cspAliases = map[string][]string{
+		CSPNameAWS:    {"AWS", "Amazon"},
+		CSPNameAzure:  {"Azure", "Microsoft"},
+		CSPNameGoogle: {"GCP", "Google"},
+		CSPNameOracle: {"Oracle", "OCI"},
+	}
+)
+
+// ProviderConfigBuilder builds the provider configuration of a cloud node for a given cloud service provider.
+type ProviderConfigBuilder interface {
+	CSPName() string
+	Build() (*ProviderCloudConfig, error)
+}
+
+// AWSProviderConfig configures a cloud node connected to AWS Direct Connect.
+type AWSProviderConfig struct {
+	AccountID string
+}
+
+func (c AWSProviderConfig) CSPName() string {
+	return CSPNameAWS
+}
+
+func (c AWSProviderConfig) Build() (*ProviderCloudConfig, error) {
+	if !awsAccountIDRegexp.MatchString(c.AccountID) {
+		return nil, ErrInvalidAWSAccountID
+	}
+
+	return &ProviderCloudConfig{AccountID: c.AccountID}, nil
+}
+
+// AzureProviderConfig configures a cloud node connected to Azure ExpressRoute.
+type AzureProviderConfig struct {
+	ServiceKey string
+}
+
+func (c AzureProviderConfig) CSPName() string {
+	return CSPNameAzure
+}
+
+func (c AzureProviderConfig) Build() (*ProviderCloudConfig, error) {
+	if !azureServiceKeyRegexp.MatchString(c.ServiceKey) {
+		return nil, ErrInvalidAzureServiceKey
+	}
+
+	return &ProviderCloudConfig{ServiceKey: c.ServiceKey}, nil
+}
+
+// GoogleProviderConfig configures a cloud node connected to GCP Partner Interconnect.
+type GoogleProviderConfig struct {
+	PairingKey string
+}
+
+func (c GoogleProviderConfig) CSPName() string {
+	return CSPNameGoogle
+}
+
+func (c GoogleProviderConfig) Build() (*ProviderCloudConfig, error) {
+	if !googlePairingKeyRegexp.MatchString(c.PairingKey) {
+		return nil, ErrInvalidGooglePairingKey
+	}
+
+	return &ProviderCloudConfig{PairingKey: c.PairingKey}, nil
+}
+
+// OracleProviderConfig configures a cloud node connected to Oracle FastConnect.
+// The OCID of the virtual circuit is sent as the service key.
+type OracleProviderConfig struct {
+	OCID string
+}
+
+func (c OracleProviderConfig) CSPName() string {
+	return CSPNameOracle
+}
+
+func (c OracleProviderConfig) Build() (*ProviderCloudConfig, error) {
+	if !oracleOCIDRegexp.MatchString(c.OCID) {
+		return nil, ErrInvalidOracleOCID
+	}
+
+	return &ProviderCloudConfig{ServiceKey: c.OCID}, nil
+}
+
+// NewProviderCloudConfig builds and validates the provider configuration of a cloud node,
+// making sure it targets the cloud service provider of the ordered product.
+func NewProviderCloudConfig(product NodeProduct, builder ProviderConfigBuilder) (*ProviderCloudConfig, error) {
+	if !matchCSPName(builder.CSPName(), product.CSPName) {
+		return nil, fmt.Errorf("%w: product %s is sold for '%s', got a '%s' configuration", ErrCSPMismatch, product.SKU, product.CSPName, builder.CSPName())
+	}
+
+	return builder.Build()
+}
+
+func matchCSPName(cspName, productCSPName string) bool {
+	for _, alias := range cspAliases[cspName] {
+		if strings.EqualFold(alias, productCSPName) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProviderCloudConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		cspName   string
+		builder   ProviderConfigBuilder
+		expect    *ProviderCloudConfig
+		expectErr error
+	}{
+		{
+			name:    "valid aws account id",
+			cspName: "AWS",
+			builder: AWSProviderConfig{AccountID: "123456789012"},
+			expect:  &ProviderCloudConfig{AccountID: "123456789012"},
+		},
+		{
+			name:      "invalid aws account id",
+			cspName:   "AWS",
+			builder:   AWSProviderConfig{AccountID: "456789"},
+			expectErr: ErrInvalidAWSAccountID,
+		},
+		{
+			name:    "valid azure service key",
+			cspName: "Azure",
+			builder: AzureProviderConfig{ServiceKey: "2a7b1f0e-3c4d-4e5f-8a9b-0c1d2e3f4a5b"},
+			expect:  &ProviderCloudConfig{ServiceKey: "2a7b1f0e-3c4d-4e5f-8a9b-0c1d2e3f4a5b"},
+		},
+		{
+			name:      "invalid azure service key",
+			cspName:   "Azure",
+			builder:   AzureProviderConfig{ServiceKey: "not-a-key"},
+			expectErr: ErrInvalidAzureServiceKey,
+		},
+		{
+			name:    "valid gcp pairing key with google product",
+			cspName: "Google",
+			builder: GoogleProviderConfig{PairingKey: "7e51371e-72a3-40b5-b844-2e3efefaee59/europe-west3/2"},
+			expect:  &ProviderCloudConfig{PairingKey: "7e51371e-72a3-40b5-b844-2e3efefaee59/europe-west3/2"},
+		},
+		{
+			name:      "invalid gcp pairing key",
+			cspName:   "GCP",
+			builder:   GoogleProviderConfig{PairingKey: "7e51371e-72a3-40b5-b844-2e3efefaee59/europe-west3/3"},
+			expectErr: ErrInvalidGooglePairingKey,
+		},
+		{
+			name:    "valid oracle ocid",
+			cspName: "Oracle",
+			builder: OracleProviderConfig{OCID: "ocid1.virtualcircuit.oc1.eu-frankfurt-1.aaaaaaaa4bnmn2ebmkw4ouebz6hdg3gyzs"},
+			expect:  &ProviderCloudConfig{ServiceKey: "ocid1.virtualcircuit.oc1.eu-frankfurt-1.aaaaaaaa4bnmn2ebmkw4ouebz6hdg3gyzs"},
+		},
+		{
+			name:      "invalid oracle ocid",
+			cspName:   "Oracle",
+			builder:   OracleProviderConfig{OCID: "virtualcircuit.oc1"},
+			expectErr: ErrInvalidOracleOCID,
+		},
+		{
+			name:      "aws account id sent to a google product",
+			cspName:   "GCP",
+			builder:   AWSProviderConfig{AccountID: "123456789012"},
+			expectErr: ErrCSPMismatch,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+		tc := tc
+		config, err := NewProviderCloudConfig(NodeProduct{CSPName: tc.cspName}, tc.builder)
+		if tc.expectErr != nil {
+			assert.ErrorIs(t, err, tc.expectErr)
+			assert.Nil(t, config)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expect, config)
+	}
+}
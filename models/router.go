@@ -0,0 +1,105 @@
+package models
+
+import "time"
+
+type PrefixFilterAction string
+
+const (
+	PrefixFilterActionPermit PrefixFilterAction = "permit"
+	PrefixFilterActionDeny   PrefixFilterAction = "deny"
+)
+
+func (pfa PrefixFilterAction) String() string {
+	return string(pfa)
+}
+
+type PrefixFilterDirection string
+
+const (
+	PrefixFilterDirectionIn  PrefixFilterDirection = "in"
+	PrefixFilterDirectionOut PrefixFilterDirection = "out"
+)
+
+func (pfd PrefixFilterDirection) String() string {
+	return string(pfd)
+}
+
+// RouterInterface addresses the interface of a router node on one of its attachments.
+type RouterInterface struct {
+	AttachmentID string `json:"attachmentId" binding:"required,uuid"`
+	IPAddress    string `json:"ipAddress" binding:"required,cidr"`
+}
+
+type BGPPeer struct {
+	Name       string `json:"name,omitempty"`
+	NeighborIP string `json:"neighborIp" binding:"required,ip"`
+	RemoteASN  int64  `json:"remoteAsn" binding:"required,min=1,max=4294967295"`
+	MD5Key     string `json:"md5Key,omitempty" binding:"omitempty,max=80"`
+}
+
+// PrefixFilter permits or denies the routes matching a prefix. The filter applies to all
+// the BGP peers when NeighborIP is empty.
+type PrefixFilter struct {
+	Prefix     string                `json:"prefix" binding:"required,cidr"`
+	Action     PrefixFilterAction    `json:"action" binding:"required,oneof=permit deny"`
+	Direction  PrefixFilterDirection `json:"direction" binding:"required,oneof=in out"`
+	GE         int                   `json:"ge,omitempty" binding:"omitempty,min=0,max=128"`
+	LE         int                   `json:"le,omitempty" binding:"omitempty,min=0,max=128,gtefield=GE"`
+	NeighborIP string                `json:"neighborIp,omitempty" binding:"omitempty,ip"`
+}
+
+type RouterConfig struct {
+	ASN           int64             `json:"asn" binding:"required,min=1,max=4294967295"`
+	Interfaces    []RouterInterface `json:"interfaces,omitempty" binding:"omitempty,unique=AttachmentID,dive"`
+	BGPPeers      []BGPPeer         `json:"bgpPeers,omitempty" binding:"omitempty,unique=NeighborIP,dive"`
+	PrefixFilters []PrefixFilter    `json:"prefixFilters,omitempty" binding:"omitempty,dive"`
+}
+
+type RouterConfigResponse struct {
+	Data RouterConfig `json:"data"`
+}
+
+type BGPSessionState string
+
+const (
+	BGPSessionStateIdle        BGPSessionState = "idle"
+	BGPSessionStateConnect     BGPSessionState = "connect"
+	BGPSessionStateActive      BGPSessionState = "active"
+	BGPSessionStateOpenSent    BGPSessionState = "opensent"
+	BGPSessionStateOpenConfirm BGPSessionState = "openconfirm"
+	BGPSessionStateEstablished BGPSessionState = "established"
+)
+
+func (bss BGPSessionState) String() string {
+	return string(bss)
+}
+
+type BGPSession struct {
+	NeighborIP         string          `json:"neighborIp"`
+	RemoteASN          int64           `json:"remoteAsn"`
+	State              BGPSessionState `json:"state"`
+	EstablishedAt      *time.Time      `json:"establishedAt,omitempty"`
+	PrefixesReceived   int             `json:"prefixesReceived"`
+	PrefixesAdvertised int             `json:"prefixesAdvertised"`
+}
+
+type RouterStatus struct {
+	NodeID      string       `json:"nodeId"`
+	BGPSessions []BGPSession `json:"bgpSessions"`
+	CheckedAt   time.Time    `json:"checkedAt"`
+}
+
+// Established reports whether all the BGP sessions of the router are established.
+func (rs *RouterStatus) Established() bool {
+	for _, session := range rs.BGPSessions {
+		if session.State != BGPSessionStateEstablished {
+			return false
+		}
+	}
+
+	return true
+}
+
+type RouterStatusResponse struct {
+	Data RouterStatus `json:"data"`
+}
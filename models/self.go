@@ -2,6 +2,44 @@ package models
 
 import "github.com/google/uuid"
 
+// Self describes the user authenticated by the personal access token.
 type Self struct {
+	ID        uuid.UUID `json:"id"`
 	AccountID uuid.UUID `json:"accountId"`
+	Name      string    `json:"name,omitempty"`
+	Email     string    `json:"email,omitempty"`
+	IsAdmin   bool      `json:"isAdmin"`
+	ProfileID string    `json:"profileId,omitempty"`
+}
+
+// Identity gathers the authenticated user, its profile and its account.
+// Profile is nil when no profile is assigned to the user.
+type Identity struct {
+	User    Self     `json:"user"`
+	Profile *Profile `json:"profile,omitempty"`
+	Account Account  `json:"account"`
+}
+
+// IsAdmin reports whether the authenticated user is an administrator of its account.
+func (i *Identity) IsAdmin() bool {
+	return i.User.IsAdmin
+}
+
+// Permissions returns the permissions granted by the profile of the user.
+func (i *Identity) Permissions() []Permission {
+	if i.Profile == nil {
+		return nil
+	}
+
+	return i.Profile.Permissions
+}
+
+// Can reports whether the authenticated user is allowed to perform action on resource.
+// Administrators are allowed everything.
+func (i *Identity) Can(resource string, action PermissionAction) bool {
+	if i.IsAdmin() {
+		return true
+	}
+
+	return can(i.Permissions(), resource, action)
 }
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+type TokenScope string
+
+const (
+	TokenScopeRead  TokenScope = "read"
+	TokenScopeWrite TokenScope = "write"
+)
+
+func (ts TokenScope) String() string {
+	return string(ts)
+}
+
+type PersonalAccessToken struct {
+	BaseModel
+	Name       string       `json:"name"`
+	Scopes     []TokenScope `json:"scopes"`
+	ExpiresAt  *time.Time   `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time   `json:"lastUsedAt,omitempty"`
+	// Token is the secret value of the token, only returned at creation.
+	Token string `json:"token,omitempty"`
+}
+
+// ExpiresBefore reports whether the token is no longer valid at t.
+// A token without expiration date never expires.
+func (pat *PersonalAccessToken) ExpiresBefore(t time.Time) bool {
+	return pat.ExpiresAt != nil && pat.ExpiresAt.Before(t)
+}
+
+type PersonalAccessTokenResponse struct {
+	Data PersonalAccessToken `json:"data"`
+}
+
+type PersonalAccessTokensResponse struct {
+	Data []PersonalAccessToken `json:"data"`
+	Meta *Pagination           `json:"meta,omitempty"`
+}
+
+type CreatePersonalAccessToken struct {
+	Name      string       `json:"name" binding:"required"`
+	Scopes    []TokenScope `json:"scopes" binding:"required,min=1,dive,oneof=read write"`
+	ExpiresAt *time.Time   `json:"expiresAt,omitempty"`
+}
@@ -8,8 +8,8 @@ type TransportProduct struct {
 }
 
 type TransportVlans struct {
-	AVlan int64 `json:"aVlan,omitempty"`
-	ZVlan int64 `json:"zVlan,omitempty"`
+	AVlan int64 `json:"aVlan,omitempty" binding:"omitempty,min=1,max=4094"`
+	ZVlan int64 `json:"zVlan,omitempty" binding:"omitempty,min=1,max=4094"`
 }
 
 type Transport struct {
@@ -30,10 +30,20 @@ func (t *Transport) GetState() AdministrativeState {
 }
 
 type CreateTransport struct {
-	Name    string     `json:"name" binding:"required"`
-	Product AddProduct `json:"product" binding:"required"`
+	Name    string          `json:"name" binding:"required"`
+	Product AddProduct      `json:"product" binding:"required"`
+	Vlans   *TransportVlans `json:"vlans,omitempty"`
+}
+
+type UpdateTransportVlans struct {
+	Vlans TransportVlans `json:"vlans"`
 }
 
 type TransportResponse struct {
 	Data Transport `json:"data"`
 }
+
+type TransportsResponse struct {
+	Data []Transport `json:"data"`
+	Meta *Pagination `json:"meta,omitempty"`
+}
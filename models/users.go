@@ -15,6 +15,7 @@ type User struct {
 	CGUAcceptedDate *time.Time `json:"cguAcceptedDate,omitempty"`
 	LastConnection  *time.Time `json:"lastConnection,omitempty"`
 	IsAdmin         bool       `json:"isAdmin"`
+	ProfileID       string     `json:"profileId,omitempty"`
 }
 
 type Users []User
@@ -23,4 +23,5 @@ type WorkspaceResponse struct {
 
 type WorkspacesResponse struct {
 	Data []Workspace `json:"data"`
+	Meta *Pagination `json:"meta,omitempty"`
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"log"
 	"net/http"
 	"strings"
@@ -28,6 +29,19 @@ func checkNodeFinishedTask(ctx context.Context, c *Client, workspaceID, nodeID s
 	return node, waiterOptionState == node.State
 }
 
+// checkNodeProductUpdated returns a waiter check which is over once the node is in the wanted state with product sku.
+// The product is checked as the node may still be in state deployed right after the update request.
+func checkNodeProductUpdated(sku string) func(context.Context, *Client, string, string, models.AdministrativeState) (*models.Node, bool) {
+	return func(ctx context.Context, c *Client, workspaceID, nodeID string, waiterOptionState models.AdministrativeState) (*models.Node, bool) {
+		node, finishedTask := checkNodeFinishedTask(ctx, c, workspaceID, nodeID, waiterOptionState)
+		if node == nil {
+			return nil, false
+		}
+
+		return node, finishedTask && node.Product.SKU == sku
+	}
+}
+
 // CreateNode creates asynchronously a cloud node. The node returned will depend of the passed option.
 // If none is passed the node will be returned once created in database with administrative state creation_pending.
 // If the option WithWaitUntilElementDeployed() is passed, the node will be returned when its state reach deployed or creation_error.
@@ -102,6 +116,23 @@ func (c *Client) GetNode(ctx context.Context, workspaceID, nodeID string) (*mode
 	return &node.Data, err
 }
 
+// ListNodes lists all the nodes of a workspace, fetching every page.
+func (c *Client) ListNodes(ctx context.Context, workspaceID string, options ...ListOption) ([]models.Node, error) {
+	return Collect(c.AllNodes(ctx, workspaceID, options...), 0)
+}
+
+// AllNodes returns an iterator over the nodes of a workspace which lazily fetches the pages.
+func (c *Client) AllNodes(ctx context.Context, workspaceID string, options ...ListOption) iter.Seq2[models.Node, error] {
+	return paginate(ctx, c, fmt.Sprintf("%s/accounts/%s/workspaces/%s/nodes", c.hostURL, c.accountID, workspaceID), options, func(resp []byte) ([]models.Node, *models.Pagination, error) {
+		nodes := models.NodesResponse{}
+		if err := json.Unmarshal(resp, &nodes); err != nil {
+			return nil, nil, err
+		}
+
+		return nodes.Data, nodes.Meta, nil
+	})
+}
+
 func (c *Client) UpdateNode(ctx context.Context, payload models.UpdateElement, workspaceID, nodeID string) (*models.Node, error) {
 	body := new(bytes.Buffer)
 	err := json.NewEncoder(body).Encode(&payload)
@@ -128,6 +159,73 @@ func (c *Client) UpdateNode(ctx context.Context, payload models.UpdateElement, w
 	return &node.Data, err
 }
 
+// UpdateNodeProduct changes in place the product of a node, e.g. to upgrade its bandwidth, without recreating it.
+// The new product must be compatible with the current one: only the bandwidth may change.
+// If the option WithWaitUntilElementDeployed() is passed, the node will be returned once the modification is over,
+// i.e. when it is back in state deployed with the new product.
+func (c *Client) UpdateNodeProduct(ctx context.Context, payload models.UpdateElementProduct, workspaceID, nodeID string, options ...OptionElement) (*models.Node, error) {
+	if errV := c.validate.StructCtx(ctx, payload); errV != nil {
+		return nil, errV
+	}
+
+	nodeOptions := &elementOptions{}
+	for _, o := range options {
+		o(nodeOptions)
+	}
+
+	current, err := c.GetNode(ctx, workspaceID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := c.ListProducts(ctx, models.ProductFilter{Family: models.ProductFamilyNode, SKU: payload.Product.SKU})
+	if err != nil {
+		return nil, err
+	}
+
+	target, found := catalog.NodeProduct(payload.Product.SKU)
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrProductNotFound, payload.Product.SKU)
+	}
+
+	if err := checkNodeProductCompatibility(current.Product, *target); err != nil {
+		return nil, err
+	}
+
+	body := new(bytes.Buffer)
+	err = json.NewEncoder(body).Encode(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/accounts/%s/workspaces/%s/nodes/%s", c.hostURL, c.accountID, workspaceID, nodeID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	node := models.NodeResponse{}
+	err = json.Unmarshal(resp, &node)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodePolled = &node.Data
+	if nodeOptions.waitUntilElementDeployed {
+		var success bool
+		nodePolled, success = WaitUntilFinishedTask(ctx, c, workspaceID, node.Data.ID.String(), models.AdministrativeStateDeployed, checkNodeProductUpdated(payload.Product.SKU))
+		if !success {
+			return nil, fmt.Errorf("Node did not reach '%s' state with product '%s' in time.", models.AdministrativeStateDeployed, payload.Product.SKU)
+		}
+	}
+
+	return nodePolled, nil
+}
+
 // DeleteNode deletes asynchronously a node. The attachment returned will depend of the option passed.
 // If none is passed the node will be returned once the request accepted, its state will be delete_pending
 // If the option WithWaitUntilElementUndeployed() is passed, the node won't be returned as it would have been deleted. However, if an error is triggered, an object could be returned with a delete_error state.
@@ -809,6 +809,44 @@ func TestGetNodeNotFound(t *testing.T) {
 	g.Expect(data).Should(BeNil())
 }
 
+func TestListNodesSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	result := models.NodesResponse{
+		Data: []models.Node{cloudNodeCreateResponse.Data, accessNodeCreateResponse.Data},
+	}
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes", accountId, workspaceID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, result),
+		),
+	)
+
+	data, err := cli.ListNodes(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal(result.Data))
+}
+
+func TestListNodesForbidden(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes", accountId, workspaceID)),
+			gh.RespondWithJSONEncoded(http.StatusForbidden, nil),
+		),
+	)
+
+	data, err := cli.ListNodes(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
+
 func TestUpdateNodeSuccessfully(t *testing.T) {
 	g := NewWithT(t)
 	gh := ghttp.NewGHTTPWithGomega(g)
@@ -1306,3 +1344,165 @@ func TestCreateAccessNodeFailedValidator(t *testing.T) {
 	g.Expect(err.Error()).Should(Equal("Key: 'CreateNode.Product.SKU' Error:Field validation for 'SKU' failed on the 'required' tag"))
 	g.Expect(data).Should(BeNil())
 }
+
+func TestUpdateNodeProductWaitForStateDeployed(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	cli.poll.maxRetry = 3
+	cli.poll.retryInterval = 10 * time.Millisecond
+
+	upgradedProduct := nodeDeployedResponse.Data.Product
+	upgradedProduct.Bandwidth = 1000
+	upgradedProduct.SKU = "CEQUFR51000AWS"
+
+	updatePending := nodeDeployedResponse
+	updatePending.Data.State = models.AdministrativeStateUpdatePending
+	updatePending.Data.Product = upgradedProduct
+
+	upgraded := nodeDeployedResponse
+	upgraded.Data.Product = upgradedProduct
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, nodeDeployedResponse),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/products", accountId), "family=node&sku=CEQUFR51000AWS"),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.ProductCatalogResponse{
+				Data: models.ProductCatalog{Nodes: []models.NodeProduct{upgradedProduct}},
+			}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPatch, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s", accountId, workspaceID, nodeID)),
+			gh.VerifyJSONRepresenting(models.UpdateElementProduct{Product: models.AddProduct{SKU: "CEQUFR51000AWS"}}),
+			gh.RespondWithJSONEncoded(http.StatusAccepted, updatePending),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, updatePending),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, upgraded),
+		),
+	)
+
+	data, err := cli.UpdateNodeProduct(
+		context.Background(),
+		models.UpdateElementProduct{Product: models.AddProduct{SKU: "CEQUFR51000AWS"}},
+		workspaceID,
+		nodeID.String(),
+		WithWaitUntilElementDeployed(),
+	)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(upgraded.Data))
+}
+
+func TestUpdateNodeProductUpdateError(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	cli.poll.maxRetry = 3
+	cli.poll.retryInterval = 10 * time.Millisecond
+
+	upgradedProduct := nodeDeployedResponse.Data.Product
+	upgradedProduct.Bandwidth = 1000
+	upgradedProduct.SKU = "CEQUFR51000AWS"
+
+	updateError := nodeDeployedResponse
+	updateError.Data.State = models.AdministrativeStateUpdateError
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, nodeDeployedResponse),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/products", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.ProductCatalogResponse{
+				Data: models.ProductCatalog{Nodes: []models.NodeProduct{upgradedProduct}},
+			}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPatch, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusAccepted, nodeDeployedResponse),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, updateError),
+		),
+	)
+
+	data, err := cli.UpdateNodeProduct(
+		context.Background(),
+		models.UpdateElementProduct{Product: models.AddProduct{SKU: "CEQUFR51000AWS"}},
+		workspaceID,
+		nodeID.String(),
+		WithWaitUntilElementDeployed(),
+	)
+
+	g.Expect(err).Should(MatchError("Node did not reach 'deployed' state with product 'CEQUFR51000AWS' in time."))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestUpdateNodeProductIncompatible(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	otherRegionProduct := nodeDeployedResponse.Data.Product
+	otherRegionProduct.SKU = "CEQUFR5100AWSIRL"
+	otherRegionProduct.CSPRegion = "eu-west-1"
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, nodeDeployedResponse),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/products", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.ProductCatalogResponse{
+				Data: models.ProductCatalog{Nodes: []models.NodeProduct{otherRegionProduct}},
+			}),
+		),
+	)
+
+	data, err := cli.UpdateNodeProduct(
+		context.Background(),
+		models.UpdateElementProduct{Product: models.AddProduct{SKU: "CEQUFR5100AWSIRL"}},
+		workspaceID,
+		nodeID.String(),
+	)
+
+	g.Expect(err).Should(MatchError(ErrIncompatibleProduct))
+	g.Expect(err.Error()).Should(ContainSubstring("cspRegion differs"))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestUpdateNodeProductNotFound(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, nodeDeployedResponse),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/products", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.ProductCatalogResponse{}),
+		),
+	)
+
+	data, err := cli.UpdateNodeProduct(
+		context.Background(),
+		models.UpdateElementProduct{Product: models.AddProduct{SKU: "UNKNOWN"}},
+		workspaceID,
+		nodeID.String(),
+	)
+
+	g.Expect(err).Should(MatchError(ErrProductNotFound))
+	g.Expect(data).Should(BeNil())
+}
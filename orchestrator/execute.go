@@ -0,0 +1,157 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// task is a unit of work which can start once all the tasks it depends on are completed.
+type task struct {
+	name string
+	deps []string
+	run  func(ctx context.Context) error
+}
+
+// checkTasks makes sure the task names are unique, the dependencies exist and do not form a cycle.
+func checkTasks(tasks []task) error {
+	byName := map[string]task{}
+	for _, t := range tasks {
+		if _, found := byName[t.name]; found {
+			return fmt.Errorf("%w: '%s' is declared twice", ErrInvalidRequest, t.name)
+		}
+		byName[t.name] = t
+	}
+
+	for _, t := range tasks {
+		for _, dep := range t.deps {
+			if _, found := byName[dep]; !found {
+				return fmt.Errorf("%w: '%s' depends on unknown '%s'", ErrInvalidRequest, t.name, dep)
+			}
+		}
+	}
+
+	// depth first search, a task met again while being visited closes a cycle
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	marks := map[string]int{}
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch marks[name] {
+		case visiting:
+			return fmt.Errorf("%w: dependency cycle through '%s'", ErrInvalidRequest, name)
+		case visited:
+			return nil
+		}
+		marks[name] = visiting
+		for _, dep := range byName[name].deps {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		marks[name] = visited
+		return nil
+	}
+	for _, t := range tasks {
+		if err := visit(t.name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type taskResult struct {
+	name string
+	err  error
+}
+
+// execute runs the tasks in dependency order, at most concurrency of them at the same time.
+// Once a task fails no new task is started, the running ones are awaited. It returns the names
+// of the completed tasks in completion order and the errors of the failed tasks joined.
+// The tasks must have been checked with checkTasks.
+func execute(ctx context.Context, tasks []task, concurrency int) ([]string, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		completed = []string{}
+		done      = map[string]bool{}
+		started   = map[string]bool{}
+		results   = make(chan taskResult)
+		running   = 0
+		errs      = []error{}
+	)
+
+	ready := func(t task) bool {
+		if started[t.name] {
+			return false
+		}
+		for _, dep := range t.deps {
+			if !done[dep] {
+				return false
+			}
+		}
+		return true
+	}
+
+	for {
+		if len(errs) == 0 && ctx.Err() == nil {
+			for _, t := range tasks {
+				if running >= concurrency {
+					break
+				}
+				if !ready(t) {
+					continue
+				}
+				started[t.name] = true
+				running++
+				go func(t task) {
+					results <- taskResult{name: t.name, err: t.run(ctx)}
+				}(t)
+			}
+		}
+
+		if running == 0 {
+			break
+		}
+
+		result := <-results
+		running--
+		if result.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.name, result.err))
+			continue
+		}
+		done[result.name] = true
+		completed = append(completed, result.name)
+	}
+
+	if len(errs) == 0 && len(completed) < len(tasks) {
+		// the context has been cancelled before every task could start
+		errs = append(errs, ctx.Err())
+	}
+
+	return completed, errors.Join(errs...)
+}
+
+// reversed returns the tasks whose dependencies are inverted, so that a task starts
+// only once all the tasks which depended on it are completed.
+func reversed(tasks []task) []task {
+	dependents := map[string][]string{}
+	for _, t := range tasks {
+		for _, dep := range t.deps {
+			dependents[dep] = append(dependents[dep], t.name)
+		}
+	}
+
+	out := make([]task, 0, len(tasks))
+	for _, t := range slices.Backward(tasks) {
+		out = append(out, task{name: t.name, deps: dependents[t.name], run: t.run})
+	}
+
+	return out
+}
@@ -0,0 +1,143 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckTasks(t *testing.T) {
+	tests := []struct {
+		name  string
+		tasks []task
+		valid bool
+	}{
+		{
+			name:  "valid dependencies",
+			tasks: []task{{name: "a"}, {name: "b", deps: []string{"a"}}, {name: "c", deps: []string{"a", "b"}}},
+			valid: true,
+		},
+		{
+			name:  "duplicated name",
+			tasks: []task{{name: "a"}, {name: "a"}},
+		},
+		{
+			name:  "unknown dependency",
+			tasks: []task{{name: "a", deps: []string{"b"}}},
+		},
+		{
+			name:  "dependency cycle",
+			tasks: []task{{name: "a", deps: []string{"c"}}, {name: "b", deps: []string{"a"}}, {name: "c", deps: []string{"b"}}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+		tc := tc
+		err := checkTasks(tc.tasks)
+		if tc.valid {
+			assert.NoError(t, err)
+		} else {
+			assert.ErrorIs(t, err, ErrInvalidRequest)
+		}
+	}
+}
+
+func TestExecuteDependencyOrderAndConcurrency(t *testing.T) {
+	var (
+		running, maxRunning atomic.Int32
+		mu                  sync.Mutex
+		started             = []string{}
+	)
+	run := func(name string) func(context.Context) error {
+		return func(context.Context) error {
+			mu.Lock()
+			started = append(started, name)
+			mu.Unlock()
+			current := running.Add(1)
+			for {
+				highest := maxRunning.Load()
+				if current <= highest || maxRunning.CompareAndSwap(highest, current) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			running.Add(-1)
+			return nil
+		}
+	}
+
+	tasks := []task{
+		{name: "attachment", deps: []string{"node-1", "transport"}, run: run("attachment")},
+		{name: "node-1", run: run("node-1")},
+		{name: "node-2", run: run("node-2")},
+		{name: "node-3", run: run("node-3")},
+		{name: "transport", run: run("transport")},
+	}
+
+	completed, err := execute(context.Background(), tasks, 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, completed, 5)
+	assert.Equal(t, "attachment", completed[4])
+	assert.Equal(t, "attachment", started[4])
+	assert.Equal(t, int32(2), maxRunning.Load())
+}
+
+func TestExecuteStopsOnFailure(t *testing.T) {
+	errBoom := errors.New("boom")
+	ran := map[string]bool{}
+	var mu sync.Mutex
+	run := func(name string, err error) func(context.Context) error {
+		return func(context.Context) error {
+			mu.Lock()
+			ran[name] = true
+			mu.Unlock()
+			return err
+		}
+	}
+
+	tasks := []task{
+		{name: "a", run: run("a", nil)},
+		{name: "b", deps: []string{"a"}, run: run("b", errBoom)},
+		{name: "c", deps: []string{"b"}, run: run("c", nil)},
+		{name: "d", deps: []string{"a"}, run: run("d", nil)},
+	}
+
+	completed, err := execute(context.Background(), tasks, 1)
+
+	assert.ErrorIs(t, err, errBoom)
+	assert.EqualError(t, err, "b: boom")
+	assert.Equal(t, []string{"a"}, completed)
+	assert.False(t, ran["c"])
+	assert.False(t, ran["d"])
+}
+
+func TestExecuteCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	completed, err := execute(ctx, []task{{name: "a", run: func(context.Context) error { return nil }}}, 1)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, completed)
+}
+
+func TestReversed(t *testing.T) {
+	tasks := reversed([]task{
+		{name: "node"},
+		{name: "transport"},
+		{name: "attachment", deps: []string{"node", "transport"}},
+	})
+
+	assert.Equal(t, []task{
+		{name: "attachment"},
+		{name: "transport", deps: []string{"attachment"}},
+		{name: "node", deps: []string{"attachment"}},
+	}, tasks)
+}
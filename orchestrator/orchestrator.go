@@ -0,0 +1,374 @@
+// Package orchestrator creates and tears down a set of elements of a workspace, running the
+// independent operations in parallel and the dependent ones in order.
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+const defaultConcurrency = 4
+
+var (
+	ErrInvalidRequest = errors.New("invalid element request")
+	ErrNotDeployed    = errors.New("element is not deployed")
+)
+
+// AttachmentRequest attaches the node and the transport requested under the given names.
+type AttachmentRequest struct {
+	Node      string
+	Transport string
+	Side      models.AttachmentSide
+}
+
+// Request asks for the creation of one element, identified by a unique name. Exactly one of Node,
+// Transport and Attachment must be set. An attachment implicitly depends on its node and transport,
+// other dependencies can be declared in DependsOn.
+type Request struct {
+	Name       string
+	Node       *models.CreateNode
+	Transport  *models.CreateTransport
+	Attachment *AttachmentRequest
+	DependsOn  []string
+}
+
+// NodeRequest returns the request of a node named name.
+func NodeRequest(name string, payload models.CreateNode, dependsOn ...string) Request {
+	return Request{Name: name, Node: &payload, DependsOn: dependsOn}
+}
+
+// TransportRequest returns the request of a transport named name.
+func TransportRequest(name string, payload models.CreateTransport, dependsOn ...string) Request {
+	return Request{Name: name, Transport: &payload, DependsOn: dependsOn}
+}
+
+// AttachmentBetween returns the request of an attachment between the node and the transport requested under the given names.
+func AttachmentBetween(name, node, transport string, side models.AttachmentSide) Request {
+	return Request{Name: name, Attachment: &AttachmentRequest{Node: node, Transport: transport, Side: side}}
+}
+
+func (r Request) kind() (models.ElementKind, error) {
+	kinds := []models.ElementKind{}
+	if r.Node != nil {
+		kinds = append(kinds, models.ElementKindNode)
+	}
+	if r.Transport != nil {
+		kinds = append(kinds, models.ElementKindTransport)
+	}
+	if r.Attachment != nil {
+		kinds = append(kinds, models.ElementKindAttachment)
+	}
+	if len(kinds) != 1 {
+		return "", fmt.Errorf("%w: '%s' must request exactly one element", ErrInvalidRequest, r.Name)
+	}
+
+	return kinds[0], nil
+}
+
+// Element is an element created by the orchestrator.
+type Element struct {
+	Name string
+	Kind models.ElementKind
+	ID   string
+	// dependsOn lists the names of the elements which had to be created first.
+	dependsOn []string
+}
+
+// Result lists the elements created, in creation order.
+type Result struct {
+	WorkspaceID string
+	Elements    []Element
+}
+
+// Element returns the element created under name.
+func (r *Result) Element(name string) (*Element, bool) {
+	for i := range r.Elements {
+		if r.Elements[i].Name == name {
+			return &r.Elements[i], true
+		}
+	}
+
+	return nil, false
+}
+
+type Orchestrator struct {
+	client      *autonomisdk.Client
+	workspaceID string
+	concurrency int
+}
+
+type Option func(*Orchestrator)
+
+// WithConcurrency sets the maximum number of elements created or deleted at the same time, 4 by default.
+func WithConcurrency(concurrency int) Option {
+	return func(o *Orchestrator) {
+		o.concurrency = concurrency
+	}
+}
+
+func New(client *autonomisdk.Client, workspaceID string, options ...Option) *Orchestrator {
+	o := &Orchestrator{
+		client:      client,
+		workspaceID: workspaceID,
+		concurrency: defaultConcurrency,
+	}
+	for _, option := range options {
+		option(o)
+	}
+
+	return o
+}
+
+// Create creates the requested elements in dependency order, waiting for each of them to be deployed
+// before starting the elements depending on it. The attachments are hence created only once both their
+// node and transport are deployed. If an element cannot be created, no new creation is started and the
+// elements already created are deleted in reverse order. The result lists the elements left in the workspace.
+func (o *Orchestrator) Create(ctx context.Context, requests []Request) (*Result, error) {
+	tasks, err := o.creationTasks(requests)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu      sync.Mutex
+		created = map[string]Element{}
+	)
+	for i := range tasks {
+		name, deps := tasks[i].name, tasks[i].deps
+		request := requestNamed(requests, name)
+		tasks[i].run = func(ctx context.Context) error {
+			mu.Lock()
+			ids := map[string]string{}
+			for n, element := range created {
+				ids[n] = element.ID
+			}
+			mu.Unlock()
+
+			element, err := o.create(ctx, request, ids)
+			if err != nil {
+				return err
+			}
+			element.dependsOn = deps
+
+			mu.Lock()
+			created[name] = *element
+			mu.Unlock()
+			return nil
+		}
+	}
+
+	completed, createErr := execute(ctx, tasks, o.concurrency)
+
+	result := &Result{WorkspaceID: o.workspaceID}
+	for _, name := range completed {
+		result.Elements = append(result.Elements, created[name])
+	}
+
+	if createErr == nil {
+		return result, nil
+	}
+
+	// the rollback must run even if the creation failed because the context was cancelled
+	rollbackErr := o.Teardown(context.WithoutCancel(ctx), result)
+	if rollbackErr != nil {
+		return result, errors.Join(createErr, fmt.Errorf("rollback failed: %w", rollbackErr))
+	}
+
+	return &Result{WorkspaceID: o.workspaceID}, createErr
+}
+
+// Teardown deletes the elements of a result in the reverse order of their creation, waiting for each of
+// them to be deleted before deleting the elements it depended on. The attachments are deleted before the
+// nodes and transports, even if the result was rebuilt by the caller. The elements deleted are removed from
+// the result, the ones left are those which could not be deleted or were not attempted after a failure.
+func (o *Orchestrator) Teardown(ctx context.Context, result *Result) error {
+	var mu sync.Mutex
+	deleted := map[string]bool{}
+
+	deps := creationDeps(result)
+	tasks := make([]task, 0, len(result.Elements))
+	for _, element := range result.Elements {
+		element := element
+		tasks = append(tasks, task{
+			name: element.Name,
+			deps: deps[element.Name],
+			run: func(ctx context.Context) error {
+				if err := o.delete(ctx, element); err != nil {
+					return err
+				}
+				mu.Lock()
+				deleted[element.Name] = true
+				mu.Unlock()
+				return nil
+			},
+		})
+	}
+
+	_, err := execute(ctx, reversed(tasks), o.concurrency)
+
+	result.Elements = slices.DeleteFunc(result.Elements, func(element Element) bool {
+		return deleted[element.Name]
+	})
+
+	return err
+}
+
+// creationDeps returns, for each element of the result, the elements which had to be created before it:
+// the dependencies recorded at creation, which a result rebuilt by the caller does not have, and for an
+// attachment the nodes and transports of the result which do not depend on it.
+func creationDeps(result *Result) map[string][]string {
+	deps := map[string][]string{}
+	for _, element := range result.Elements {
+		deps[element.Name] = slices.DeleteFunc(slices.Clone(element.dependsOn), func(dep string) bool {
+			_, found := result.Element(dep)
+			return !found
+		})
+	}
+
+	// reaches reports whether to is a direct or indirect dependency of from
+	var reaches func(from, to string, seen map[string]bool) bool
+	reaches = func(from, to string, seen map[string]bool) bool {
+		if from == to {
+			return true
+		}
+		if seen[from] {
+			return false
+		}
+		seen[from] = true
+		for _, dep := range deps[from] {
+			if reaches(dep, to, seen) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, attachment := range result.Elements {
+		if attachment.Kind != models.ElementKindAttachment {
+			continue
+		}
+		for _, element := range result.Elements {
+			if element.Kind == models.ElementKindAttachment || slices.Contains(deps[attachment.Name], element.Name) {
+				continue
+			}
+			// an element depending on the attachment keeps being deleted first
+			if !reaches(element.Name, attachment.Name, map[string]bool{}) {
+				deps[attachment.Name] = append(deps[attachment.Name], element.Name)
+			}
+		}
+	}
+
+	return deps
+}
+
+func requestNamed(requests []Request, name string) Request {
+	for _, request := range requests {
+		if request.Name == name {
+			return request
+		}
+	}
+
+	return Request{}
+}
+
+// creationTasks checks the requests and returns their tasks, without the function running them.
+func (o *Orchestrator) creationTasks(requests []Request) ([]task, error) {
+	kinds := map[string]models.ElementKind{}
+	for _, request := range requests {
+		if request.Name == "" {
+			return nil, fmt.Errorf("%w: element name is required", ErrInvalidRequest)
+		}
+		kind, err := request.kind()
+		if err != nil {
+			return nil, err
+		}
+		kinds[request.Name] = kind
+	}
+
+	tasks := make([]task, 0, len(requests))
+	for _, request := range requests {
+		deps := slices.Clone(request.DependsOn)
+		if attachment := request.Attachment; attachment != nil {
+			if kinds[attachment.Node] != models.ElementKindNode {
+				return nil, fmt.Errorf("%w: attachment '%s' references '%s' which is not a requested node", ErrInvalidRequest, request.Name, attachment.Node)
+			}
+			if kinds[attachment.Transport] != models.ElementKindTransport {
+				return nil, fmt.Errorf("%w: attachment '%s' references '%s' which is not a requested transport", ErrInvalidRequest, request.Name, attachment.Transport)
+			}
+			deps = append(deps, attachment.Node, attachment.Transport)
+		}
+		tasks = append(tasks, task{name: request.Name, deps: deps})
+	}
+
+	if err := checkTasks(tasks); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// create creates the element of a request and waits until it is deployed. ids maps the names
+// of the elements already created to their ids.
+func (o *Orchestrator) create(ctx context.Context, request Request, ids map[string]string) (*Element, error) {
+	wait := autonomisdk.WithWaitUntilElementDeployed()
+
+	switch {
+	case request.Node != nil:
+		node, err := o.client.CreateNode(ctx, *request.Node, o.workspaceID, wait)
+		if err != nil {
+			return nil, err
+		}
+		if node.State != models.AdministrativeStateDeployed {
+			return nil, fmt.Errorf("%w: node is '%s'", ErrNotDeployed, node.State)
+		}
+		return &Element{Name: request.Name, Kind: models.ElementKindNode, ID: node.ID.String()}, nil
+
+	case request.Transport != nil:
+		transport, err := o.client.CreateTransport(ctx, *request.Transport, o.workspaceID, wait)
+		if err != nil {
+			return nil, err
+		}
+		if transport.State != models.AdministrativeStateDeployed {
+			return nil, fmt.Errorf("%w: transport is '%s'", ErrNotDeployed, transport.State)
+		}
+		return &Element{Name: request.Name, Kind: models.ElementKindTransport, ID: transport.ID.String()}, nil
+
+	default:
+		payload := models.CreateAttachment{
+			NodeID:      ids[request.Attachment.Node],
+			TransportID: ids[request.Attachment.Transport],
+			Side:        request.Attachment.Side,
+		}
+		attachment, err := o.client.CreateAttachment(ctx, payload, o.workspaceID, wait)
+		if err != nil {
+			return nil, err
+		}
+		if attachment.State != models.AdministrativeStateDeployed {
+			return nil, fmt.Errorf("%w: attachment is '%s'", ErrNotDeployed, attachment.State)
+		}
+		return &Element{Name: request.Name, Kind: models.ElementKindAttachment, ID: attachment.ID.String()}, nil
+	}
+}
+
+// delete deletes an element and waits until it is gone.
+func (o *Orchestrator) delete(ctx context.Context, element Element) error {
+	wait := autonomisdk.WithWaitUntilElementUndeployed()
+
+	var err error
+	switch element.Kind {
+	case models.ElementKindNode:
+		_, err = o.client.DeleteNode(ctx, o.workspaceID, element.ID, wait)
+	case models.ElementKindTransport:
+		_, err = o.client.DeleteTransport(ctx, o.workspaceID, element.ID, wait)
+	case models.ElementKindAttachment:
+		_, err = o.client.DeleteAttachment(ctx, o.workspaceID, element.ID, wait)
+	}
+
+	return err
+}
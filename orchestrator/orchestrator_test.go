@@ -0,0 +1,230 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+const (
+	accountID   = "e1b4ff0f-8ab1-4b2e-a0b4-2fb8e6c0b1c2"
+	workspaceID = "84d2e5a6-3f14-4b9a-9d5c-1e6f0a2b7c3d"
+)
+
+// fakeAPI is an in-memory workspace: created elements are deployed at the first poll.
+type fakeAPI struct {
+	mu      sync.Mutex
+	names   map[string]string
+	deleted map[string]bool
+	// failing lists the names of the elements whose creation is rejected
+	failing map[string]bool
+	// links maps the id of a node or a transport to the ids of its attachments
+	links map[string][]string
+	// calls records the operations in the order they were received, e.g. "create node paris"
+	calls []string
+}
+
+var elementPath = regexp.MustCompile(fmt.Sprintf(`^/accounts/%s/workspaces/%s/(nodes|transports|attachments)(?:/([^/]+))?$`, accountID, workspaceID))
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	match := elementPath.FindStringSubmatch(r.URL.Path)
+	kind, id := strings.TrimSuffix(match[1], "s"), match[2]
+
+	respond := func(status int, id, name string, state models.AdministrativeState) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"id": id, "name": name, "administrativeState": state},
+		})
+	}
+
+	switch {
+	case r.Method == http.MethodPost:
+		payload := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		name := payload["name"]
+		if kind == "attachment" {
+			name = f.names[payload["nodeId"]] + "/" + f.names[payload["transportId"]]
+		}
+		f.calls = append(f.calls, fmt.Sprintf("create %s %s", kind, name))
+		if f.failing[name] {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		id := uuid.NewString()
+		f.names[id] = name
+		if kind == "attachment" {
+			f.links[payload["nodeId"]] = append(f.links[payload["nodeId"]], id)
+			f.links[payload["transportId"]] = append(f.links[payload["transportId"]], id)
+		}
+		respond(http.StatusCreated, id, name, models.AdministrativeStateCreationPending)
+
+	case r.Method == http.MethodGet && id == "":
+		respond(http.StatusOK, "", "", "")
+
+	case r.Method == http.MethodGet:
+		if f.deleted[id] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		respond(http.StatusOK, id, f.names[id], models.AdministrativeStateDeployed)
+
+	case r.Method == http.MethodDelete:
+		f.calls = append(f.calls, fmt.Sprintf("delete %s %s", kind, f.names[id]))
+		// an element still attached cannot be deleted
+		for _, attachmentID := range f.links[id] {
+			if !f.deleted[attachmentID] {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+		}
+		f.deleted[id] = true
+		respond(http.StatusOK, id, f.names[id], models.AdministrativeStateDeletePending)
+	}
+}
+
+func (f *fakeAPI) recorded() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.calls)
+}
+
+func setupOrchestrator(t *testing.T, failing ...string) (*WithT, *fakeAPI, *autonomisdk.Client) {
+	g := NewWithT(t)
+	gh := ghttp.NewGHTTPWithGomega(g)
+	server := ghttp.NewServer()
+	t.Cleanup(server.Close)
+
+	api := &fakeAPI{names: map[string]string{}, deleted: map[string]bool{}, failing: map[string]bool{}, links: map[string][]string{}}
+	for _, name := range failing {
+		api.failing[name] = true
+	}
+
+	server.RouteToHandler(http.MethodGet, "/users/self",
+		gh.RespondWithJSONEncoded(http.StatusOK, models.Self{AccountID: uuid.MustParse(accountID)}),
+	)
+	for _, method := range []string{http.MethodPost, http.MethodGet, http.MethodDelete} {
+		server.RouteToHandler(method, elementPath, api.ServeHTTP)
+	}
+
+	hostURL, err := url.Parse(server.URL())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	client, err := autonomisdk.NewClient(
+		true,
+		autonomisdk.WithHostURL(hostURL),
+		autonomisdk.WithPersonalAccessToken("token"),
+		autonomisdk.WithPolling(5*time.Millisecond, 5),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return g, api, client
+}
+
+func requests() []Request {
+	return []Request{
+		NodeRequest("paris", models.CreateNode{Name: "paris", Type: models.NodeTypeAccess, Product: models.AddProduct{SKU: "ACCESS-PAR-1G"}}),
+		NodeRequest("london", models.CreateNode{Name: "london", Type: models.NodeTypeAccess, Product: models.AddProduct{SKU: "ACCESS-LON-1G"}}),
+		TransportRequest("backbone", models.CreateTransport{Name: "backbone", Product: models.AddProduct{SKU: "TRANSPORT-1G"}}),
+		AttachmentBetween("paris/backbone", "paris", "backbone", ""),
+		AttachmentBetween("london/backbone", "london", "backbone", ""),
+	}
+}
+
+func TestCreateAndTeardown(t *testing.T) {
+	g, api, client := setupOrchestrator(t)
+	o := New(client, workspaceID, WithConcurrency(3))
+
+	result, err := o.Create(context.Background(), requests())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(result.Elements).Should(HaveLen(5))
+	attachment, found := result.Element("paris/backbone")
+	g.Expect(found).Should(BeTrue())
+	g.Expect(attachment.Kind).Should(Equal(models.ElementKindAttachment))
+
+	calls := api.recorded()
+	g.Expect(calls[:3]).Should(ConsistOf("create node paris", "create node london", "create transport backbone"))
+	g.Expect(calls[3:]).Should(ConsistOf("create attachment paris/backbone", "create attachment london/backbone"))
+
+	err = o.Teardown(context.Background(), result)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(result.Elements).Should(BeEmpty())
+	calls = api.recorded()[5:]
+	g.Expect(calls[:2]).Should(ConsistOf("delete attachment paris/backbone", "delete attachment london/backbone"))
+	g.Expect(calls[2:]).Should(ConsistOf("delete node paris", "delete node london", "delete transport backbone"))
+}
+
+func TestTeardownRebuiltResult(t *testing.T) {
+	g, api, client := setupOrchestrator(t)
+	o := New(client, workspaceID, WithConcurrency(5))
+
+	created, err := o.Create(context.Background(), requests())
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// the result is persisted by the caller, only its exported fields are kept
+	rebuilt := &Result{WorkspaceID: created.WorkspaceID}
+	for _, element := range created.Elements {
+		rebuilt.Elements = append(rebuilt.Elements, Element{Name: element.Name, Kind: element.Kind, ID: element.ID})
+	}
+
+	err = o.Teardown(context.Background(), rebuilt)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rebuilt.Elements).Should(BeEmpty())
+	calls := api.recorded()[5:]
+	g.Expect(calls[:2]).Should(ConsistOf("delete attachment paris/backbone", "delete attachment london/backbone"))
+	g.Expect(calls[2:]).Should(ConsistOf("delete node paris", "delete node london", "delete transport backbone"))
+}
+
+func TestCreateRollsBackOnFailure(t *testing.T) {
+	g, api, client := setupOrchestrator(t, "backbone")
+	o := New(client, workspaceID, WithConcurrency(1))
+
+	result, err := o.Create(context.Background(), requests())
+
+	g.Expect(err).Should(MatchError(ContainSubstring("backbone: status: 400")))
+	g.Expect(result.Elements).Should(BeEmpty())
+	g.Expect(api.recorded()).Should(Equal([]string{
+		"create node paris",
+		"create node london",
+		"create transport backbone",
+		"delete node london",
+		"delete node paris",
+	}))
+}
+
+func TestCreateInvalidRequests(t *testing.T) {
+	g, api, client := setupOrchestrator(t)
+	o := New(client, workspaceID)
+
+	_, err := o.Create(context.Background(), []Request{
+		NodeRequest("paris", models.CreateNode{Name: "paris", Type: models.NodeTypeAccess, Product: models.AddProduct{SKU: "ACCESS-PAR-1G"}}),
+		AttachmentBetween("paris/backbone", "paris", "backbone", ""),
+	})
+	g.Expect(err).Should(MatchError(ErrInvalidRequest))
+
+	_, err = o.Create(context.Background(), []Request{{Name: "empty"}})
+	g.Expect(err).Should(MatchError(ErrInvalidRequest))
+
+	g.Expect(api.recorded()).Should(BeEmpty())
+}
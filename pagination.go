@@ -0,0 +1,122 @@
+package autonomisdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"strconv"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// defaultPageSize is the number of items requested per page when none is set.
+const defaultPageSize = 100
+
+var ErrMaxItemsExceeded = errors.New("maximum number of items exceeded")
+
+// pageDecoder decodes a page returned by a list endpoint. Pagination metadata are nil
+// when the endpoint does not return any.
+type pageDecoder[T any] func(resp []byte) ([]T, *models.Pagination, error)
+
+// paginate returns an iterator which lazily fetches the pages of the list endpoint at rawURL.
+// The iteration stops at the first error, which is yielded with a zero value.
+func paginate[T any](ctx context.Context, c *Client, rawURL string, options []ListOption, decode pageDecoder[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		opts := newListOptions(options...)
+		if err := opts.validate(); err != nil {
+			yield(zero, err)
+			return
+		}
+
+		// do not fetch more items than the limit
+		if opts.limit > 0 && opts.pageSize == 0 && opts.limit < defaultPageSize {
+			opts.pageSize = opts.limit
+		}
+
+		pageSize := opts.pageSize
+		if pageSize <= 0 {
+			pageSize = defaultPageSize
+		}
+
+		count := 0
+		var previous []byte
+
+		for page := 1; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			// the page size is always sent so that the end of the list does not rely on the server default
+			q := req.URL.Query()
+			opts.encode(q)
+			if page > 1 {
+				q.Set("page", strconv.Itoa(page))
+			}
+			q.Set("pageSize", strconv.Itoa(pageSize))
+			req.URL.RawQuery = q.Encode()
+
+			resp, err := c.doRequest(req)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			// an endpoint ignoring the page parameter returns the same page again
+			if bytes.Equal(resp, previous) {
+				return
+			}
+			previous = resp
+
+			items, meta, err := decode(resp)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+				count++
+				if opts.limit > 0 && count >= opts.limit {
+					return
+				}
+			}
+
+			// without metadata, a page which is not full is the last one, and a page
+			// larger than requested means the endpoint is not paginated
+			if len(items) == 0 || (meta != nil && page >= meta.TotalPages) || (meta == nil && len(items) != pageSize) {
+				return
+			}
+		}
+	}
+}
+
+// Collect gathers all the items of seq. If maxItems is positive and seq holds more items,
+// the first maxItems items are returned along with ErrMaxItemsExceeded.
+func Collect[T any](seq iter.Seq2[T, error], maxItems int) ([]T, error) {
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		if maxItems > 0 && len(items) == maxItems {
+			return items, fmt.Errorf("%w: more than %d items", ErrMaxItemsExceeded, maxItems)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,162 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+func workspacesPage(page, totalPages int, names ...string) models.WorkspacesResponse {
+	response := models.WorkspacesResponse{
+		Meta: &models.Pagination{
+			Page:       page,
+			PageSize:   len(names),
+			TotalPages: totalPages,
+		},
+	}
+	for _, name := range names {
+		response.Data = append(response.Data, models.Workspace{
+			BaseModel: models.BaseModel{
+				ID: uuid.New(),
+			},
+			Name: name,
+		})
+	}
+
+	return response
+}
+
+func TestAllWorkspacesFetchesEveryPage(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces", accountId), "pageSize=2"),
+			gh.RespondWithJSONEncoded(http.StatusOK, workspacesPage(1, 2, "first", "second")),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces", accountId), "page=2&pageSize=2"),
+			gh.RespondWithJSONEncoded(http.StatusOK, workspacesPage(2, 2, "third")),
+		),
+	)
+
+	names := []string{}
+	for workspace, err := range cli.AllWorkspaces(context.Background(), uuid.MustParse(accountId), WithPageSize(2)) {
+		g.Expect(err).ShouldNot(HaveOccurred())
+		names = append(names, workspace.Name)
+	}
+
+	g.Expect(names).Should(Equal([]string{"first", "second", "third"}))
+	g.Expect(server.ReceivedRequests()).Should(HaveLen(3))
+}
+
+func TestAllWorkspacesStopsLazily(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, workspacesPage(1, 5, "first", "second")),
+		),
+	)
+
+	for workspace, err := range cli.AllWorkspaces(context.Background(), uuid.MustParse(accountId)) {
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(workspace.Name).Should(Equal("first"))
+		break
+	}
+
+	// the second page must not have been requested
+	g.Expect(server.ReceivedRequests()).Should(HaveLen(2))
+}
+
+func TestListUsersWithoutPaginationMetadata(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/users", accountId), "pageSize=1"),
+			gh.RespondWithJSONEncoded(http.StatusOK, usersListResponse),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/users", accountId), "page=2&pageSize=1"),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.Users{}),
+		),
+	)
+
+	data, err := cli.ListUsers(context.Background(), uuid.MustParse(accountId), WithPageSize(1))
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal(usersListResponse))
+}
+
+func TestAllWorkspacesErrorOnSecondPage(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, workspacesPage(1, 2, "first")),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces", accountId), "page=2&pageSize=100"),
+			gh.RespondWithJSONEncoded(http.StatusInternalServerError, nil),
+		),
+	)
+
+	data, err := cli.ListWorkspaces(context.Background(), uuid.MustParse(accountId))
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
+
+func TestCollectMaxItems(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, workspacesPage(1, 1, "first", "second", "third")),
+		),
+	)
+
+	data, err := Collect(cli.AllWorkspaces(context.Background(), uuid.MustParse(accountId)), 2)
+
+	g.Expect(err).Should(MatchError(ErrMaxItemsExceeded))
+	g.Expect(data).Should(HaveLen(2))
+}
+
+func TestAllUsersServerIgnoringPage(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	users := models.Users{user, user}
+	server.RouteToHandler(http.MethodGet, fmt.Sprintf("/accounts/%s/users", accountId),
+		gh.RespondWithJSONEncoded(http.StatusOK, users),
+	)
+
+	// the full list is returned whatever the page, the same page must not be fetched again
+	data, err := Collect(cli.AllUsers(context.Background(), uuid.MustParse(accountId), WithPageSize(2)), 0)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal([]models.User(users)))
+	g.Expect(server.ReceivedRequests()).Should(HaveLen(3))
+
+	// a page larger than requested is the whole list
+	data, err = Collect(cli.AllUsers(context.Background(), uuid.MustParse(accountId), WithPageSize(1)), 0)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(HaveLen(2))
+	g.Expect(server.ReceivedRequests()).Should(HaveLen(4))
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/intercloud/autonomi-sdk/models"
@@ -61,6 +62,9 @@ func (c *Client) GetPhysicalPort(ctx context.Context, portID string) (*models.Ph
 	return &physicalPort.Data, err
 }
 
+// ListPort lists the physical ports of the account, optionally filtered by administrative state.
+//
+// Deprecated: use ListPhysicalPorts which supports context and richer filters.
 func (c *Client) ListPort(options ...OptionElement) (*[]models.PhysicalPort, error) {
 
 	// retrieve options from request
@@ -69,17 +73,52 @@ func (c *Client) ListPort(options ...OptionElement) (*[]models.PhysicalPort, err
 		o(portOptions)
 	}
 
-	// run request
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/accounts/%s/ports", c.hostURL, c.accountID), nil)
+	var listOptions []ListOption
+	if portOptions.administrativeState != "" {
+		listOptions = append(listOptions, FilterByState(portOptions.administrativeState))
+	}
+
+	ports, err := c.ListPhysicalPorts(context.Background(), listOptions...)
 	if err != nil {
 		return nil, err
 	}
 
-	// add query param if needed
-	if portOptions.administrativeState != "" {
-		q := req.URL.Query()
-		q.Add("state", portOptions.administrativeState.String())
-		req.URL.RawQuery = q.Encode()
+	return &ports, nil
+}
+
+// ListPhysicalPorts lists the physical ports of the account, fetching every page. Results can be filtered
+// with FilterByProvider, FilterByLocation, FilterByMinAvailableBandwidth and FilterByState.
+func (c *Client) ListPhysicalPorts(ctx context.Context, options ...ListOption) ([]models.PhysicalPort, error) {
+	return Collect(c.AllPhysicalPorts(ctx, options...), 0)
+}
+
+// AllPhysicalPorts returns an iterator over the physical ports of the account which lazily fetches the pages.
+func (c *Client) AllPhysicalPorts(ctx context.Context, options ...ListOption) iter.Seq2[models.PhysicalPort, error] {
+	return paginate(ctx, c, fmt.Sprintf("%s/accounts/%s/ports", c.hostURL, c.accountID), options, func(resp []byte) ([]models.PhysicalPort, *models.Pagination, error) {
+		ports := models.PhysicalPortListResponse{}
+		if err := json.Unmarshal(resp, &ports); err != nil {
+			return nil, nil, err
+		}
+
+		return ports.Data, ports.Meta, nil
+	})
+}
+
+// UpdatePhysicalPort renames a physical port.
+func (c *Client) UpdatePhysicalPort(ctx context.Context, payload models.UpdatePhysicalPort, portID string) (*models.PhysicalPort, error) {
+	body := new(bytes.Buffer)
+	err := json.NewEncoder(body).Encode(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if errV := c.validate.StructCtx(ctx, payload); errV != nil {
+		return nil, errV
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/accounts/%s/ports/%s", c.hostURL, c.accountID, portID), body)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := c.doRequest(req)
@@ -87,13 +126,13 @@ func (c *Client) ListPort(options ...OptionElement) (*[]models.PhysicalPort, err
 		return nil, err
 	}
 
-	ports := models.PhysicalPortListResponse{}
-	err = json.Unmarshal(resp, &ports)
+	physicalPort := models.PhysicalPortSingleResponse{}
+	err = json.Unmarshal(resp, &physicalPort)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ports.Data, err
+	return &physicalPort.Data, nil
 }
 
 // DeletePhysicalPort creates a physical port in Autonomi platform. As
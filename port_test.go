@@ -417,7 +417,7 @@ func TestListPhysicalPortWithState(t *testing.T) {
 	result := portCreatedListResponse
 	server.AppendHandlers(
 		ghttp.CombineHandlers(
-			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/ports", accountId), "state=created"),
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/ports", accountId), "pageSize=100&state=created"),
 			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
 			gh.RespondWithJSONEncoded(http.StatusOK, portCreatedListResponse),
 		),
@@ -530,3 +530,113 @@ func TestDeletePhysicalPortForbidden(t *testing.T) {
 
 	g.Expect(err).ShouldNot(BeNil())
 }
+
+func TestListPhysicalPortsWithFilters(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	// mock testing response
+	result := portCreatedListResponse
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/ports", accountId), "location=Paris&minAvailableBandwidth=1000&pageSize=100&provider=EQUINIX&state=deployed&state=created"),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, portCreatedListResponse),
+		),
+	)
+
+	// run target function through testing framework
+	data, err := cli.ListPhysicalPorts(
+		context.Background(),
+		FilterByProvider(models.ProviderTypeEquinix),
+		FilterByLocation("Paris"),
+		FilterByMinAvailableBandwidth(1000),
+		FilterByState(models.AdministrativeStateDeployed, models.AdministrativeStateCreated),
+	)
+
+	// test results
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal(result.Data))
+}
+
+func TestListPhysicalPortsForbidden(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/ports", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusForbidden, nil),
+		),
+	)
+
+	data, err := cli.ListPhysicalPorts(context.Background())
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
+
+func TestUpdatePhysicalPortSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	result := portCreatedSingleResponse
+	result.Data.Name = "physical_port_updated_name"
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPatch, fmt.Sprintf("/accounts/%s/ports/%s", accountId, physicalPortId)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.VerifyJSONRepresenting(models.UpdatePhysicalPort{Name: "physical_port_updated_name"}),
+			gh.RespondWithJSONEncoded(http.StatusOK, result),
+		),
+	)
+
+	data, err := cli.UpdatePhysicalPort(
+		context.Background(),
+		models.UpdatePhysicalPort{
+			Name: "physical_port_updated_name",
+		},
+		physicalPortId.String(),
+	)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(result.Data))
+}
+
+func TestUpdatePhysicalPortFailedValidator(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.UpdatePhysicalPort(
+		context.Background(),
+		models.UpdatePhysicalPort{},
+		physicalPortId.String(),
+	)
+
+	g.Expect(err.Error()).Should(Equal("Key: 'UpdatePhysicalPort.Name' Error:Field validation for 'Name' failed on the 'required' tag"))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestUpdatePhysicalPortNotFound(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPatch, fmt.Sprintf("/accounts/%s/ports/%s", accountId, physicalPortId)),
+			gh.RespondWithJSONEncoded(http.StatusNotFound, nil),
+		),
+	)
+
+	data, err := cli.UpdatePhysicalPort(
+		context.Background(),
+		models.UpdatePhysicalPort{
+			Name: "physical_port_updated_name",
+		},
+		physicalPortId.String(),
+	)
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
@@ -0,0 +1,117 @@
+package autonomisdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// ListProducts searches the product catalog. The SKU of the returned products can be used
+// to create nodes, transports and physical ports.
+func (c *Client) ListProducts(ctx context.Context, filter models.ProductFilter) (*models.ProductCatalog, error) {
+	if errV := c.validate.StructCtx(ctx, filter); errV != nil {
+		return nil, errV
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/accounts/%s/products", c.hostURL, c.accountID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	encodeProductFilter(filter, q)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := models.ProductCatalogResponse{}
+	if err = json.Unmarshal(resp, &catalog); err != nil {
+		return nil, err
+	}
+
+	return &catalog.Data, nil
+}
+
+func encodeProductFilter(filter models.ProductFilter, q url.Values) {
+	params := map[string]string{
+		"family":     filter.Family.String(),
+		"sku":        filter.SKU,
+		"provider":   filter.Provider.String(),
+		"location":   filter.Location,
+		"locationTo": filter.LocationTo,
+		"cspName":    filter.CSPName,
+		"cspRegion":  filter.CSPRegion,
+		"type":       filter.Type.String(),
+	}
+	for key, value := range params {
+		if value != "" {
+			q.Set(key, value)
+		}
+	}
+
+	if filter.Bandwidth > 0 {
+		q.Set("bandwidth", strconv.Itoa(filter.Bandwidth))
+	}
+}
+
+var (
+	ErrProductNotFound     = errors.New("product not found in the catalog")
+	ErrProductUnchanged    = errors.New("element already uses this product")
+	ErrIncompatibleProduct = errors.New("product is not compatible with the element")
+)
+
+// checkNodeProductCompatibility makes sure a node can switch in place from product current to product target:
+// only the bandwidth may change, the location and the cloud service provider must stay the same.
+func checkNodeProductCompatibility(current, target models.NodeProduct) error {
+	if current.SKU == target.SKU {
+		return ErrProductUnchanged
+	}
+
+	checks := []struct {
+		field           string
+		current, target string
+	}{
+		{"location", current.Location, target.Location},
+		{"provider", current.Provider.String(), target.Provider.String()},
+		{"cspName", current.CSPName, target.CSPName},
+		{"cspRegion", current.CSPRegion, target.CSPRegion},
+		{"type", current.Type.String(), target.Type.String()},
+	}
+	for _, check := range checks {
+		if !strings.EqualFold(check.current, check.target) {
+			return fmt.Errorf("%w: %s differs, '%s' instead of '%s'", ErrIncompatibleProduct, check.field, check.target, check.current)
+		}
+	}
+
+	return nil
+}
+
+// checkTransportProductCompatibility makes sure a transport can switch in place from product current to product target:
+// only the bandwidth may change, both ends must stay the same.
+func checkTransportProductCompatibility(current, target models.TransportProduct) error {
+	if current.SKU == target.SKU {
+		return ErrProductUnchanged
+	}
+
+	if !strings.EqualFold(current.Provider.String(), target.Provider.String()) {
+		return fmt.Errorf("%w: provider differs, '%s' instead of '%s'", ErrIncompatibleProduct, target.Provider, current.Provider)
+	}
+
+	sameEnds := strings.EqualFold(current.Location, target.Location) && strings.EqualFold(current.LocationTo, target.LocationTo)
+	swappedEnds := strings.EqualFold(current.Location, target.LocationTo) && strings.EqualFold(current.LocationTo, target.Location)
+	if !sameEnds && !swappedEnds {
+		return fmt.Errorf("%w: locations differ, '%s - %s' instead of '%s - %s'", ErrIncompatibleProduct, target.Location, target.LocationTo, current.Location, current.LocationTo)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var (
+	productCatalogResponse = models.ProductCatalogResponse{
+		Data: models.ProductCatalog{
+			Nodes: []models.NodeProduct{
+				{
+					Product: models.Product{
+						Provider:  models.ProviderTypeEquinix,
+						Location:  "Equinix FR5",
+						Bandwidth: 100,
+						SKU:       "CEQUFR5100AWS",
+					},
+					CSPName:   "AWS",
+					CSPRegion: "eu-central-1",
+					Type:      models.AccessProductTypePhysical,
+				},
+			},
+			Transports: []models.TransportProduct{
+				{
+					Product: models.Product{
+						Provider:  models.ProviderTypeEquinix,
+						Location:  "Equinix FR5",
+						Bandwidth: 100,
+						SKU:       "TEQUFR5PA3100",
+					},
+					LocationTo: "Equinix PA3",
+				},
+			},
+		},
+	}
+)
+
+func TestListProductsSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	result := productCatalogResponse
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/products", accountId), "bandwidth=100&cspName=AWS&cspRegion=eu-central-1&location=Equinix+FR5&provider=EQUINIX&type=PHYSICAL"),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, productCatalogResponse),
+		),
+	)
+
+	data, err := cli.ListProducts(
+		context.Background(),
+		models.ProductFilter{
+			Provider:  models.ProviderTypeEquinix,
+			Location:  "Equinix FR5",
+			CSPName:   "AWS",
+			CSPRegion: "eu-central-1",
+			Bandwidth: 100,
+			Type:      models.AccessProductTypePhysical,
+		},
+	)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(result.Data))
+
+	nodeProduct, found := data.NodeProduct("CEQUFR5100AWS")
+	g.Expect(found).Should(BeTrue())
+	g.Expect(nodeProduct.CSPName).Should(Equal("AWS"))
+
+	_, found = data.TransportProduct("CEQUFR5100AWS")
+	g.Expect(found).Should(BeFalse())
+}
+
+func TestListProductsFailedValidator(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.ListProducts(
+		context.Background(),
+		models.ProductFilter{
+			Type: "HYBRID",
+		},
+	)
+
+	g.Expect(err.Error()).Should(Equal("Key: 'ProductFilter.Type' Error:Field validation for 'Type' failed on the 'oneof' tag"))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestListProductsForbidden(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/products", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusForbidden, nil),
+		),
+	)
+
+	data, err := cli.ListProducts(context.Background(), models.ProductFilter{})
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
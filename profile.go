@@ -0,0 +1,47 @@
+package autonomisdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// ListProfiles lists all the user profiles of the account, fetching every page.
+func (c *Client) ListProfiles(ctx context.Context, options ...ListOption) ([]models.Profile, error) {
+	return Collect(c.AllProfiles(ctx, options...), 0)
+}
+
+// AllProfiles returns an iterator over the user profiles of the account which lazily fetches the pages.
+func (c *Client) AllProfiles(ctx context.Context, options ...ListOption) iter.Seq2[models.Profile, error] {
+	return paginate(ctx, c, fmt.Sprintf("%s/accounts/%s/profiles", c.hostURL, c.accountID), options, func(resp []byte) ([]models.Profile, *models.Pagination, error) {
+		profiles := models.ProfilesResponse{}
+		if err := json.Unmarshal(resp, &profiles); err != nil {
+			return nil, nil, err
+		}
+
+		return profiles.Data, profiles.Meta, nil
+	})
+}
+
+func (c *Client) GetProfile(ctx context.Context, profileID string) (*models.Profile, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/accounts/%s/profiles/%s", c.hostURL, c.accountID, profileID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := models.ProfileResponse{}
+	if err = json.Unmarshal(resp, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile.Data, nil
+}
@@ -0,0 +1,106 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var (
+	readOnlyProfileID = uuid.MustParse("5a1e5c6d-8b0f-4a8e-9f3c-7d2e1b0a9c8d")
+	networkProfileID  = uuid.MustParse("6b2f6d7e-9c1a-4b9f-8a4d-8e3f2c1b0d9e")
+
+	readOnlyProfile = models.Profile{
+		BaseModel: models.BaseModel{
+			ID: readOnlyProfileID,
+		},
+		Name: "noc",
+		Permissions: []models.Permission{
+			{
+				Resource: models.PermissionResourceAll,
+				Actions:  []models.PermissionAction{models.PermissionActionRead},
+			},
+		},
+	}
+
+	networkProfile = models.Profile{
+		BaseModel: models.BaseModel{
+			ID: networkProfileID,
+		},
+		Name: "network",
+		Permissions: []models.Permission{
+			{
+				Resource: models.PermissionResourceAll,
+				Actions:  []models.PermissionAction{models.PermissionActionRead},
+			},
+			{
+				Resource: models.PermissionResourceTransport,
+				Actions:  []models.PermissionAction{models.PermissionActionWrite, models.PermissionActionDelete},
+			},
+		},
+	}
+)
+
+func TestListProfilesSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/profiles", accountId)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, models.ProfilesResponse{
+				Data: []models.Profile{readOnlyProfile, networkProfile},
+			}),
+		),
+	)
+
+	data, err := cli.ListProfiles(context.Background())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal([]models.Profile{readOnlyProfile, networkProfile}))
+}
+
+func TestGetProfileSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/profiles/%s", accountId, networkProfileID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, models.ProfileResponse{Data: networkProfile}),
+		),
+	)
+
+	data, err := cli.GetProfile(context.Background(), networkProfileID.String())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(networkProfile))
+	g.Expect(data.Can(models.PermissionResourceNode, models.PermissionActionRead)).Should(BeTrue())
+	g.Expect(data.Can(models.PermissionResourceTransport, models.PermissionActionWrite)).Should(BeTrue())
+	g.Expect(data.Can(models.PermissionResourceNode, models.PermissionActionWrite)).Should(BeFalse())
+}
+
+func TestGetProfileNotFound(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/profiles/%s", accountId, networkProfileID)),
+			gh.RespondWithJSONEncoded(http.StatusNotFound, nil),
+		),
+	)
+
+	data, err := cli.GetProfile(context.Background(), networkProfileID.String())
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
@@ -0,0 +1,73 @@
+package autonomisdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// AssignWorkspaceRole grants the permissions of a profile to a user on a workspace.
+func (c *Client) AssignWorkspaceRole(ctx context.Context, payload models.AssignWorkspaceRole, workspaceID string) (*models.WorkspaceRole, error) {
+	body := new(bytes.Buffer)
+	err := json.NewEncoder(body).Encode(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if errV := c.validate.StructCtx(ctx, payload); errV != nil {
+		return nil, errV
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/accounts/%s/workspaces/%s/roles", c.hostURL, c.accountID, workspaceID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	role := models.WorkspaceRoleResponse{}
+	if err = json.Unmarshal(resp, &role); err != nil {
+		return nil, err
+	}
+
+	return &role.Data, nil
+}
+
+// ListWorkspaceRoles lists all the roles granted on a workspace, fetching every page.
+func (c *Client) ListWorkspaceRoles(ctx context.Context, workspaceID string, options ...ListOption) ([]models.WorkspaceRole, error) {
+	return Collect(c.AllWorkspaceRoles(ctx, workspaceID, options...), 0)
+}
+
+// AllWorkspaceRoles returns an iterator over the roles granted on a workspace which lazily fetches the pages.
+func (c *Client) AllWorkspaceRoles(ctx context.Context, workspaceID string, options ...ListOption) iter.Seq2[models.WorkspaceRole, error] {
+	return paginate(ctx, c, fmt.Sprintf("%s/accounts/%s/workspaces/%s/roles", c.hostURL, c.accountID, workspaceID), options, func(resp []byte) ([]models.WorkspaceRole, *models.Pagination, error) {
+		roles := models.WorkspaceRolesResponse{}
+		if err := json.Unmarshal(resp, &roles); err != nil {
+			return nil, nil, err
+		}
+
+		return roles.Data, roles.Meta, nil
+	})
+}
+
+// RevokeWorkspaceRole removes a role granted on a workspace.
+func (c *Client) RevokeWorkspaceRole(ctx context.Context, workspaceID, roleID string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/accounts/%s/workspaces/%s/roles/%s", c.hostURL, c.accountID, workspaceID, roleID), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.doRequest(req); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var (
+	workspaceRoleID = uuid.MustParse("7c3a7e8f-0d2b-4c0a-9b5e-9f4a3d2c1e0f")
+
+	workspaceRole = models.WorkspaceRole{
+		BaseModel: models.BaseModel{
+			ID: workspaceRoleID,
+		},
+		WorkspaceID: workspaceID,
+		UserID:      userId,
+		ProfileID:   readOnlyProfileID,
+	}
+)
+
+func TestAssignWorkspaceRoleSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	payload := models.AssignWorkspaceRole{
+		UserID:    userId.String(),
+		ProfileID: readOnlyProfileID.String(),
+	}
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPost, fmt.Sprintf("/accounts/%s/workspaces/%s/roles", accountId, workspaceID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.VerifyJSONRepresenting(payload),
+			gh.RespondWithJSONEncoded(http.StatusCreated, models.WorkspaceRoleResponse{Data: workspaceRole}),
+		),
+	)
+
+	data, err := cli.AssignWorkspaceRole(context.Background(), payload, workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(workspaceRole))
+}
+
+func TestAssignWorkspaceRoleFailedValidator(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.AssignWorkspaceRole(
+		context.Background(),
+		models.AssignWorkspaceRole{
+			UserID: userId.String(),
+		},
+		workspaceID,
+	)
+
+	g.Expect(err.Error()).Should(Equal("Key: 'AssignWorkspaceRole.ProfileID' Error:Field validation for 'ProfileID' failed on the 'required' tag"))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestListWorkspaceRolesSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/roles", accountId, workspaceID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, models.WorkspaceRolesResponse{
+				Data: []models.WorkspaceRole{workspaceRole},
+			}),
+		),
+	)
+
+	data, err := cli.ListWorkspaceRoles(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal([]models.WorkspaceRole{workspaceRole}))
+}
+
+func TestRevokeWorkspaceRoleSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodDelete, fmt.Sprintf("/accounts/%s/workspaces/%s/roles/%s", accountId, workspaceID, workspaceRoleID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusNoContent, nil),
+		),
+	)
+
+	err := cli.RevokeWorkspaceRole(context.Background(), workspaceID, workspaceRoleID.String())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestRevokeWorkspaceRoleForbidden(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodDelete, fmt.Sprintf("/accounts/%s/workspaces/%s/roles/%s", accountId, workspaceID, workspaceRoleID)),
+			gh.RespondWithJSONEncoded(http.StatusForbidden, nil),
+		),
+	)
+
+	err := cli.RevokeWorkspaceRole(context.Background(), workspaceID, workspaceRoleID.String())
+
+	g.Expect(err).ShouldNot(BeNil())
+}
@@ -0,0 +1,82 @@
+package autonomisdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// GetRouterConfig retrieves the routing configuration of a router node.
+func (c *Client) GetRouterConfig(ctx context.Context, workspaceID, nodeID string) (*models.RouterConfig, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/accounts/%s/workspaces/%s/nodes/%s/routing", c.hostURL, c.accountID, workspaceID, nodeID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	routerConfig := models.RouterConfigResponse{}
+	if err = json.Unmarshal(resp, &routerConfig); err != nil {
+		return nil, err
+	}
+
+	return &routerConfig.Data, nil
+}
+
+// UpdateRouterConfig replaces the routing configuration of a router node: ASN, interfaces addressing,
+// BGP peers and prefix filters.
+func (c *Client) UpdateRouterConfig(ctx context.Context, payload models.RouterConfig, workspaceID, nodeID string) (*models.RouterConfig, error) {
+	body := new(bytes.Buffer)
+	err := json.NewEncoder(body).Encode(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if errV := c.validate.StructCtx(ctx, payload); errV != nil {
+		return nil, errV
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/accounts/%s/workspaces/%s/nodes/%s/routing", c.hostURL, c.accountID, workspaceID, nodeID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	routerConfig := models.RouterConfigResponse{}
+	if err = json.Unmarshal(resp, &routerConfig); err != nil {
+		return nil, err
+	}
+
+	return &routerConfig.Data, nil
+}
+
+// GetRouterStatus retrieves the state of the BGP sessions of a router node.
+func (c *Client) GetRouterStatus(ctx context.Context, workspaceID, nodeID string) (*models.RouterStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/accounts/%s/workspaces/%s/nodes/%s/routing/status", c.hostURL, c.accountID, workspaceID, nodeID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	routerStatus := models.RouterStatusResponse{}
+	if err = json.Unmarshal(resp, &routerStatus); err != nil {
+		return nil, err
+	}
+
+	return &routerStatus.Data, nil
+}
@@ -0,0 +1,187 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var (
+	routerConfig = models.RouterConfig{
+		ASN: 65000,
+		Interfaces: []models.RouterInterface{
+			{
+				AttachmentID: attachmentID.String(),
+				IPAddress:    "169.254.10.1/30",
+			},
+		},
+		BGPPeers: []models.BGPPeer{
+			{
+				Name:       "aws",
+				NeighborIP: "169.254.10.2",
+				RemoteASN:  64512,
+				MD5Key:     "secret",
+			},
+		},
+		PrefixFilters: []models.PrefixFilter{
+			{
+				Prefix:    "10.0.0.0/8",
+				Action:    models.PrefixFilterActionPermit,
+				Direction: models.PrefixFilterDirectionIn,
+				LE:        24,
+			},
+		},
+	}
+
+	routerEstablishedAt = time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	routerStatusResponse = models.RouterStatusResponse{
+		Data: models.RouterStatus{
+			NodeID: nodeID.String(),
+			BGPSessions: []models.BGPSession{
+				{
+					NeighborIP:       "169.254.10.2",
+					RemoteASN:        64512,
+					State:            models.BGPSessionStateEstablished,
+					EstablishedAt:    &routerEstablishedAt,
+					PrefixesReceived: 3,
+				},
+				{
+					NeighborIP: "169.254.20.2",
+					RemoteASN:  64513,
+					State:      models.BGPSessionStateActive,
+				},
+			},
+		},
+	}
+)
+
+func TestGetRouterConfigSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s/routing", accountId, workspaceID, nodeID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, models.RouterConfigResponse{Data: routerConfig}),
+		),
+	)
+
+	data, err := cli.GetRouterConfig(context.Background(), workspaceID, nodeID.String())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(routerConfig))
+}
+
+func TestUpdateRouterConfigSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPut, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s/routing", accountId, workspaceID, nodeID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.VerifyJSONRepresenting(routerConfig),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.RouterConfigResponse{Data: routerConfig}),
+		),
+	)
+
+	data, err := cli.UpdateRouterConfig(context.Background(), routerConfig, workspaceID, nodeID.String())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(routerConfig))
+}
+
+func TestUpdateRouterConfigFailedValidator(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	invalidNeighbor := routerConfig
+	invalidNeighbor.BGPPeers = []models.BGPPeer{{NeighborIP: "169.254.10", RemoteASN: 64512}}
+
+	data, err := cli.UpdateRouterConfig(context.Background(), invalidNeighbor, workspaceID, nodeID.String())
+
+	g.Expect(err.Error()).Should(Equal("Key: 'RouterConfig.BGPPeers[0].NeighborIP' Error:Field validation for 'NeighborIP' failed on the 'ip' tag"))
+	g.Expect(data).Should(BeNil())
+
+	duplicatedPeers := routerConfig
+	duplicatedPeers.BGPPeers = []models.BGPPeer{routerConfig.BGPPeers[0], routerConfig.BGPPeers[0]}
+
+	data, err = cli.UpdateRouterConfig(context.Background(), duplicatedPeers, workspaceID, nodeID.String())
+
+	g.Expect(err.Error()).Should(Equal("Key: 'RouterConfig.BGPPeers' Error:Field validation for 'BGPPeers' failed on the 'unique' tag"))
+	g.Expect(data).Should(BeNil())
+
+	invalidFilter := routerConfig
+	invalidFilter.PrefixFilters = []models.PrefixFilter{{Prefix: "10.0.0.0/8", Action: models.PrefixFilterActionDeny, Direction: models.PrefixFilterDirectionOut, GE: 24, LE: 16}}
+
+	data, err = cli.UpdateRouterConfig(context.Background(), invalidFilter, workspaceID, nodeID.String())
+
+	g.Expect(err.Error()).Should(Equal("Key: 'RouterConfig.PrefixFilters[0].LE' Error:Field validation for 'LE' failed on the 'gtefield' tag"))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestCreateRouterNodeFailedValidator(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.CreateNode(
+		context.Background(),
+		models.CreateNode{
+			Name:    "router",
+			Type:    models.NodeTypeRouter,
+			Product: models.AddProduct{SKU: "RINTFR5"},
+			RouterConfig: &models.RouterConfig{
+				ASN: 0,
+			},
+		},
+		workspaceID,
+	)
+
+	g.Expect(err.Error()).Should(Equal("Key: 'CreateNode.RouterConfig.ASN' Error:Field validation for 'ASN' failed on the 'required' tag"))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestGetRouterStatusSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	result := routerStatusResponse
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s/routing/status", accountId, workspaceID, nodeID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, routerStatusResponse),
+		),
+	)
+
+	data, err := cli.GetRouterStatus(context.Background(), workspaceID, nodeID.String())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(result.Data))
+	g.Expect(data.Established()).Should(BeFalse())
+}
+
+func TestGetRouterStatusNotFound(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s/routing/status", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusNotFound, nil),
+		),
+	)
+
+	data, err := cli.GetRouterStatus(context.Background(), workspaceID, nodeID.String())
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
@@ -1,6 +1,7 @@
 package autonomisdk
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,21 +11,58 @@ import (
 )
 
 func (c *Client) GetSelf() (uuid.UUID, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/users/self", c.hostURL), nil)
+	self, err := c.getSelf(context.Background())
 	if err != nil {
 		return uuid.Nil, err
 	}
 
+	return self.AccountID, nil
+}
+
+// WhoAmI returns the full identity of the authenticated user: the user itself,
+// its profile and permissions, and its account.
+func (c *Client) WhoAmI(ctx context.Context) (*models.Identity, error) {
+	self, err := c.getSelf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := c.GetAccount(ctx, self.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account %s: %w", self.AccountID, err)
+	}
+
+	identity := &models.Identity{
+		User:    *self,
+		Account: *account,
+	}
+
+	if self.ProfileID != "" {
+		profile, err := c.GetProfile(ctx, self.ProfileID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get profile %s: %w", self.ProfileID, err)
+		}
+		identity.Profile = profile
+	}
+
+	return identity, nil
+}
+
+func (c *Client) getSelf(ctx context.Context) (*models.Self, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/users/self", c.hostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.doRequest(req)
 	if err != nil {
-		return uuid.Nil, err
+		return nil, err
 	}
 
 	self := models.Self{}
-	err = json.Unmarshal(resp, &self)
-	if err != nil {
-		return uuid.Nil, err
+	if err = json.Unmarshal(resp, &self); err != nil {
+		return nil, err
 	}
 
-	return self.AccountID, nil
+	return &self, nil
 }
@@ -0,0 +1,100 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+func TestWhoAmISuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	self := models.Self{
+		ID:        userId,
+		AccountID: uuid.MustParse(accountId),
+		Name:      "network operator",
+		Email:     "noc@example.com",
+		ProfileID: networkProfileID.String(),
+	}
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, "/users/self"),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, self),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, accountCreateResponse),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/profiles/%s", accountId, networkProfileID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.ProfileResponse{Data: networkProfile}),
+		),
+	)
+
+	identity, err := cli.WhoAmI(context.Background())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(identity.User).Should(Equal(self))
+	g.Expect(identity.Account).Should(Equal(accountCreateResponse))
+	g.Expect(*identity.Profile).Should(Equal(networkProfile))
+	g.Expect(identity.IsAdmin()).Should(BeFalse())
+	g.Expect(identity.Can(models.PermissionResourceTransport, models.PermissionActionWrite)).Should(BeTrue())
+	g.Expect(identity.Can(models.PermissionResourceNode, models.PermissionActionWrite)).Should(BeFalse())
+}
+
+func TestWhoAmIAdminWithoutProfile(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, "/users/self"),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.Self{
+				ID:        userId,
+				AccountID: uuid.MustParse(accountId),
+				IsAdmin:   true,
+			}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, accountCreateResponse),
+		),
+	)
+
+	identity, err := cli.WhoAmI(context.Background())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(identity.Profile).Should(BeNil())
+	g.Expect(identity.Permissions()).Should(BeEmpty())
+	g.Expect(identity.Can(models.PermissionResourceAccount, models.PermissionActionDelete)).Should(BeTrue())
+}
+
+func TestWhoAmIFailedAccount(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, "/users/self"),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.Self{AccountID: uuid.MustParse(accountId)}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusForbidden, nil),
+		),
+	)
+
+	identity, err := cli.WhoAmI(context.Background())
+
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(identity).Should(BeNil())
+}
@@ -0,0 +1,88 @@
+package autonomisdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// GetServiceKey retrieves the service key of a cloud node.
+func (c *Client) GetServiceKey(ctx context.Context, workspaceID, nodeID string) (*models.ServiceKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.serviceKeyURL(workspaceID, nodeID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doServiceKeyRequest(req)
+}
+
+// RegenerateServiceKey replaces the service key of a cloud node by a new one and returns it.
+// The previous key can no longer be used by the partner.
+func (c *Client) RegenerateServiceKey(ctx context.Context, workspaceID, nodeID string) (*models.ServiceKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.serviceKeyURL(workspaceID, nodeID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doServiceKeyRequest(req)
+}
+
+// RevokeServiceKey revokes the service key of a cloud node.
+func (c *Client) RevokeServiceKey(ctx context.Context, workspaceID, nodeID string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.serviceKeyURL(workspaceID, nodeID), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.doRequest(req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ExpiringServiceKeys returns the nodes of a workspace whose service key expires within the given window,
+// including the ones already expired. Nodes are sorted by expiration date, the soonest first.
+func (c *Client) ExpiringServiceKeys(ctx context.Context, workspaceID string, window time.Duration) ([]models.Node, error) {
+	nodes, err := c.ListNodes(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(window)
+	expiring := []models.Node{}
+	for _, node := range nodes {
+		if node.ServiceKey != nil && node.ServiceKey.ExpiresBefore(deadline) {
+			expiring = append(expiring, node)
+		}
+	}
+
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].ServiceKey.ExpirationDate.Before(expiring[j].ServiceKey.ExpirationDate)
+	})
+
+	return expiring, nil
+}
+
+func (c *Client) serviceKeyURL(workspaceID, nodeID string) string {
+	return fmt.Sprintf("%s/accounts/%s/workspaces/%s/nodes/%s/service-key", c.hostURL, c.accountID, workspaceID, nodeID)
+}
+
+func (c *Client) doServiceKeyRequest(req *http.Request) (*models.ServiceKey, error) {
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceKey := models.ServiceKeyResponse{}
+	if err = json.Unmarshal(resp, &serviceKey); err != nil {
+		return nil, err
+	}
+
+	return &serviceKey.Data, nil
+}
@@ -0,0 +1,154 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var (
+	serviceKeyResponse = models.ServiceKeyResponse{
+		Data: models.ServiceKey{
+			ID:             "1a2b3c4d",
+			ExpirationDate: time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Name:           "service_key_name",
+		},
+	}
+)
+
+func nodeWithServiceKey(expirationDate time.Time) models.Node {
+	return models.Node{
+		BaseModel: models.BaseModel{
+			ID: uuid.New(),
+		},
+		WorkspaceID: workspaceID,
+		Type:        models.NodeTypeCloud,
+		ServiceKey: &models.ServiceKey{
+			ID:             uuid.NewString(),
+			ExpirationDate: expirationDate,
+		},
+	}
+}
+
+func TestGetServiceKeySuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	result := serviceKeyResponse
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s/service-key", accountId, workspaceID, nodeID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, serviceKeyResponse),
+		),
+	)
+
+	data, err := cli.GetServiceKey(context.Background(), workspaceID, nodeID.String())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(result.Data))
+}
+
+func TestGetServiceKeyNotFound(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s/service-key", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusNotFound, nil),
+		),
+	)
+
+	data, err := cli.GetServiceKey(context.Background(), workspaceID, nodeID.String())
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
+
+func TestRegenerateServiceKeySuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	result := serviceKeyResponse
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPost, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s/service-key", accountId, workspaceID, nodeID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusCreated, serviceKeyResponse),
+		),
+	)
+
+	data, err := cli.RegenerateServiceKey(context.Background(), workspaceID, nodeID.String())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(result.Data))
+}
+
+func TestRevokeServiceKeySuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodDelete, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s/service-key", accountId, workspaceID, nodeID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusNoContent, nil),
+		),
+	)
+
+	err := cli.RevokeServiceKey(context.Background(), workspaceID, nodeID.String())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestRevokeServiceKeyForbidden(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodDelete, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s/service-key", accountId, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusForbidden, nil),
+		),
+	)
+
+	err := cli.RevokeServiceKey(context.Background(), workspaceID, nodeID.String())
+
+	g.Expect(err).ShouldNot(BeNil())
+}
+
+func TestExpiringServiceKeys(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	expiresSoon := nodeWithServiceKey(now.Add(2 * time.Hour))
+	expiresLater := nodeWithServiceKey(now.Add(30 * 24 * time.Hour))
+	expired := nodeWithServiceKey(now.Add(-time.Hour))
+	withoutExpiration := nodeWithServiceKey(time.Time{})
+	withoutServiceKey := accessNodeCreateResponse.Data
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes", accountId, workspaceID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.NodesResponse{
+				Data: []models.Node{expiresSoon, expiresLater, expired, withoutExpiration, withoutServiceKey},
+			}),
+		),
+	)
+
+	data, err := cli.ExpiringServiceKeys(context.Background(), workspaceID, 24*time.Hour)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(HaveLen(2))
+	g.Expect(data[0].ID).Should(Equal(expired.ID))
+	g.Expect(data[1].ID).Should(Equal(expiresSoon.ID))
+}
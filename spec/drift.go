@@ -0,0 +1,290 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// MaskedSecret replaces the secrets of the provider configurations in drift reports and exported specs.
+// It must be replaced by the actual secret before a spec is applied.
+const MaskedSecret = "<masked>"
+
+type DriftKind string
+
+const (
+	// DriftKindMissing is an element of the desired spec which does not exist in the workspace.
+	DriftKindMissing DriftKind = "missing"
+	// DriftKindUnexpected is an element of the workspace which is not in the desired spec.
+	DriftKindUnexpected DriftKind = "unexpected"
+	// DriftKindChanged is an element whose live fields differ from the desired spec.
+	DriftKindChanged DriftKind = "changed"
+)
+
+func (dk DriftKind) String() string {
+	return string(dk)
+}
+
+// FieldDrift is a field whose live value differs from the desired one.
+type FieldDrift struct {
+	Field    string `json:"field"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+func (fd FieldDrift) String() string {
+	return fmt.Sprintf("%s expected %s, got %s", fd.Field, fd.Expected, fd.Actual)
+}
+
+// Drift is an element of the workspace which does not match the desired spec.
+type Drift struct {
+	Kind        DriftKind          `json:"kind"`
+	ElementKind models.ElementKind `json:"elementKind"`
+	Name        string             `json:"name"`
+	// ID is the id of the live element, empty for a missing element.
+	ID     string       `json:"id,omitempty"`
+	Fields []FieldDrift `json:"fields,omitempty"`
+}
+
+func (d Drift) String() string {
+	s := fmt.Sprintf("%s %s '%s'", d.Kind, d.ElementKind, d.Name)
+	if len(d.Fields) > 0 {
+		fields := make([]string, 0, len(d.Fields))
+		for _, field := range d.Fields {
+			fields = append(fields, field.String())
+		}
+		s += ": " + strings.Join(fields, ", ")
+	}
+
+	return s
+}
+
+// DriftReport lists the differences between a desired spec and the live workspace.
+type DriftReport struct {
+	WorkspaceID string    `json:"workspaceId"`
+	CheckedAt   time.Time `json:"checkedAt"`
+	Drifts      []Drift   `json:"drifts"`
+}
+
+// HasDrift reports whether the live workspace differs from the desired spec.
+func (r *DriftReport) HasDrift() bool {
+	return len(r.Drifts) > 0
+}
+
+// JSON returns the report encoded in indented JSON.
+func (r *DriftReport) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+func (r *DriftReport) String() string {
+	if !r.HasDrift() {
+		return fmt.Sprintf("workspace %s: no drift", r.WorkspaceID)
+	}
+
+	lines := []string{fmt.Sprintf("workspace %s: %d drift(s)", r.WorkspaceID, len(r.Drifts))}
+	for _, drift := range r.Drifts {
+		lines = append(lines, drift.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// DetectDrift compares the desired nodes, transports and attachments to the live workspace.
+// Only the fields set in the desired spec are compared, e.g. the VLANs of a transport are
+// ignored if they are not declared. Elements being deleted are ignored.
+func DetectDrift(ctx context.Context, client *autonomisdk.Client, workspaceID string, desired *WorkspaceSpec) (*DriftReport, error) {
+	spec := *desired
+	spec.WorkspaceID = workspaceID
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	report := &DriftReport{
+		WorkspaceID: workspaceID,
+		CheckedAt:   time.Now(),
+		Drifts:      []Drift{},
+	}
+
+	l, err := fetchLive(ctx, client, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	wantedNodes := map[string]bool{}
+	for _, node := range spec.Nodes {
+		wantedNodes[node.Name] = true
+		current, found := l.nodes[node.Name]
+		if !found {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftKindMissing, ElementKind: models.ElementKindNode, Name: node.Name})
+			continue
+		}
+		if fields := nodeDrift(node, current); len(fields) > 0 {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftKindChanged, ElementKind: models.ElementKindNode, Name: node.Name, ID: current.ID.String(), Fields: fields})
+		}
+	}
+	for name, node := range l.nodes {
+		if !wantedNodes[name] {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftKindUnexpected, ElementKind: models.ElementKindNode, Name: name, ID: node.ID.String()})
+		}
+	}
+
+	wantedTransports := map[string]bool{}
+	for _, transport := range spec.Transports {
+		wantedTransports[transport.Name] = true
+		current, found := l.transports[transport.Name]
+		if !found {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftKindMissing, ElementKind: models.ElementKindTransport, Name: transport.Name})
+			continue
+		}
+		if fields := transportDrift(transport, current); len(fields) > 0 {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftKindChanged, ElementKind: models.ElementKindTransport, Name: transport.Name, ID: current.ID.String(), Fields: fields})
+		}
+	}
+	for name, transport := range l.transports {
+		if !wantedTransports[name] {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftKindUnexpected, ElementKind: models.ElementKindTransport, Name: name, ID: transport.ID.String()})
+		}
+	}
+
+	wantedAttachments := map[string]bool{}
+	for _, attachment := range spec.Attachments {
+		wantedAttachments[attachment.Name()] = true
+		current, found := l.attachments[attachment.Name()]
+		if !found {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftKindMissing, ElementKind: models.ElementKindAttachment, Name: attachment.Name()})
+			continue
+		}
+		if attachment.Side != "" && attachment.Side != current.Side {
+			report.Drifts = append(report.Drifts, Drift{
+				Kind:        DriftKindChanged,
+				ElementKind: models.ElementKindAttachment,
+				Name:        attachment.Name(),
+				ID:          current.ID.String(),
+				Fields:      []FieldDrift{{Field: fieldSide, Expected: attachment.Side.String(), Actual: current.Side.String()}},
+			})
+		}
+	}
+	for name, attachment := range l.attachments {
+		if !wantedAttachments[name] {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftKindUnexpected, ElementKind: models.ElementKindAttachment, Name: name, ID: attachment.ID.String()})
+		}
+	}
+
+	sortDrifts(report.Drifts)
+
+	return report, nil
+}
+
+func nodeDrift(desired NodeSpec, current models.Node) []FieldDrift {
+	fields := []FieldDrift{}
+	if current.Type != "" && desired.Type != current.Type {
+		fields = append(fields, FieldDrift{Field: fieldType, Expected: desired.Type.String(), Actual: current.Type.String()})
+	}
+	if desired.SKU != current.Product.SKU {
+		fields = append(fields, FieldDrift{Field: fieldSKU, Expected: desired.SKU, Actual: current.Product.SKU})
+	}
+	if desired.Vlan != 0 && desired.Vlan != current.Vlan {
+		fields = append(fields, FieldDrift{Field: fieldVlan, Expected: fmt.Sprint(desired.Vlan), Actual: fmt.Sprint(current.Vlan)})
+	}
+	if portID := physicalPortID(current); desired.PhysicalPortID != "" && desired.PhysicalPortID != portID {
+		fields = append(fields, FieldDrift{Field: fieldPhysicalPortID, Expected: desired.PhysicalPortID, Actual: portID})
+	}
+	// the secrets are masked in the report as it may be stored or shared
+	if desired.ProviderConfig != nil && !matchProviderConfig(*desired.ProviderConfig, current.ProviderConfig) {
+		fields = append(fields, FieldDrift{Field: fieldProviderConfig, Expected: formatProviderConfig(maskProviderConfig(desired.ProviderConfig)), Actual: formatProviderConfig(maskProviderConfig(current.ProviderConfig))})
+	}
+
+	return fields
+}
+
+func transportDrift(desired TransportSpec, current models.Transport) []FieldDrift {
+	fields := []FieldDrift{}
+	if desired.SKU != current.Product.SKU {
+		fields = append(fields, FieldDrift{Field: fieldSKU, Expected: desired.SKU, Actual: current.Product.SKU})
+	}
+	if vlans := mergeVlans(desired.Vlans, current.TransportVlans); vlans != current.TransportVlans {
+		fields = append(fields, FieldDrift{Field: fieldVlans, Expected: formatVlans(vlans), Actual: formatVlans(current.TransportVlans)})
+	}
+
+	return fields
+}
+
+// matchProviderConfig compares the provider configs, ignoring the masked secrets of the desired one.
+func matchProviderConfig(desired models.ProviderCloudConfig, current *models.ProviderCloudConfig) bool {
+	if current == nil {
+		return false
+	}
+
+	if desired.AccountID == MaskedSecret {
+		desired.AccountID = current.AccountID
+	}
+	if desired.PairingKey == MaskedSecret {
+		desired.PairingKey = current.PairingKey
+	}
+	if desired.ServiceKey == MaskedSecret {
+		desired.ServiceKey = current.ServiceKey
+	}
+
+	return desired == *current
+}
+
+// maskProviderConfig returns a copy of the config whose pairing and service keys are masked.
+func maskProviderConfig(config *models.ProviderCloudConfig) *models.ProviderCloudConfig {
+	if config == nil {
+		return nil
+	}
+
+	masked := *config
+	if masked.PairingKey != "" {
+		masked.PairingKey = MaskedSecret
+	}
+	if masked.ServiceKey != "" {
+		masked.ServiceKey = MaskedSecret
+	}
+
+	return &masked
+}
+
+func formatProviderConfig(config *models.ProviderCloudConfig) string {
+	if config == nil {
+		return "none"
+	}
+
+	values := []string{}
+	if config.AccountID != "" {
+		values = append(values, "accountId="+config.AccountID)
+	}
+	if config.PairingKey != "" {
+		values = append(values, "pairingKey="+config.PairingKey)
+	}
+	if config.ServiceKey != "" {
+		values = append(values, "serviceKey="+config.ServiceKey)
+	}
+	if len(values) == 0 {
+		return "none"
+	}
+
+	return strings.Join(values, " ")
+}
+
+var elementKindOrder = map[models.ElementKind]int{
+	models.ElementKindNode:       0,
+	models.ElementKindTransport:  1,
+	models.ElementKindAttachment: 2,
+}
+
+// sortDrifts sorts the drifts by kind of element then by name.
+func sortDrifts(drifts []Drift) {
+	slices.SortFunc(drifts, func(a, b Drift) int {
+		if a.ElementKind != b.ElementKind {
+			return elementKindOrder[a.ElementKind] - elementKindOrder[b.ElementKind]
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
@@ -0,0 +1,139 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+func TestDetectDrift(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	paris := node(parisID, "paris", "ACCESS-PAR-1G", models.AdministrativeStateDeployed)
+	paris.Vlan = 100
+	aws := node(londonID, "aws-renamed", "AWS-FRA-1G", models.AdministrativeStateDeployed)
+	aws.Type = models.NodeTypeCloud
+	backbone := transport(backboneID, "backbone", "TRANSPORT-1G", models.AdministrativeStateDeployed)
+	backbone.TransportVlans = models.TransportVlans{AVlan: 100, ZVlan: 300}
+	link := attachment(newLinkID, parisID, backboneID, models.AdministrativeStateDeployed)
+	link.Side = models.AttachmentSideA
+
+	routeLiveWorkspace(server, gh,
+		[]models.Node{paris, aws, node(uuid.New(), "gone", "ACCESS-PAR-1G", models.AdministrativeStateDeleted)},
+		[]models.Transport{backbone},
+		[]models.Attachment{link},
+	)
+
+	desired := &WorkspaceSpec{
+		Nodes: []NodeSpec{
+			{Name: "paris", Type: models.NodeTypeAccess, SKU: "ACCESS-PAR-1G", Vlan: 100},
+			{Name: "aws", Type: models.NodeTypeCloud, SKU: "AWS-FRA-1G", ProviderConfig: &models.ProviderCloudConfig{AccountID: "123456789012"}},
+		},
+		Transports: []TransportSpec{
+			{Name: "backbone", SKU: "TRANSPORT-10G", Vlans: &models.TransportVlans{AVlan: 100, ZVlan: 200}},
+		},
+		Attachments: []AttachmentSpec{
+			{Node: "paris", Transport: "backbone", Side: models.AttachmentSideA},
+		},
+	}
+
+	report, err := DetectDrift(context.Background(), client, workspaceID, desired)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(report.HasDrift()).Should(BeTrue())
+	g.Expect(report.Drifts).Should(Equal([]Drift{
+		{Kind: DriftKindMissing, ElementKind: models.ElementKindNode, Name: "aws"},
+		{Kind: DriftKindUnexpected, ElementKind: models.ElementKindNode, Name: "aws-renamed", ID: londonID.String()},
+		{
+			Kind:        DriftKindChanged,
+			ElementKind: models.ElementKindTransport,
+			Name:        "backbone",
+			ID:          backboneID.String(),
+			Fields: []FieldDrift{
+				{Field: "sku", Expected: "TRANSPORT-10G", Actual: "TRANSPORT-1G"},
+				{Field: "vlans", Expected: "A=100 Z=200", Actual: "A=100 Z=300"},
+			},
+		},
+	}))
+	g.Expect(report.String()).Should(Equal(`workspace ` + workspaceID + `: 3 drift(s)
+missing node 'aws'
+unexpected node 'aws-renamed'
+changed transport 'backbone': sku expected TRANSPORT-10G, got TRANSPORT-1G, vlans expected A=100 Z=200, got A=100 Z=300`))
+
+	raw, err := report.JSON()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	decoded := DriftReport{}
+	g.Expect(json.Unmarshal(raw, &decoded)).Should(Succeed())
+	g.Expect(decoded.Drifts).Should(Equal(report.Drifts))
+	g.Expect(decoded.WorkspaceID).Should(Equal(workspaceID))
+}
+
+func TestDetectDriftProviderConfig(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	aws := node(londonID, "aws", "AWS-FRA-1G", models.AdministrativeStateDeployed)
+	aws.Type = models.NodeTypeCloud
+	aws.ProviderConfig = &models.ProviderCloudConfig{AccountID: "210987654321"}
+
+	routeLiveWorkspace(server, gh, []models.Node{aws}, nil, nil)
+
+	report, err := DetectDrift(context.Background(), client, workspaceID, &WorkspaceSpec{
+		Nodes: []NodeSpec{
+			{Name: "aws", Type: models.NodeTypeCloud, SKU: "AWS-FRA-1G", ProviderConfig: &models.ProviderCloudConfig{AccountID: "123456789012"}},
+		},
+	})
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(report.Drifts).Should(HaveLen(1))
+	g.Expect(report.Drifts[0].Fields).Should(Equal([]FieldDrift{
+		{Field: "providerConfig", Expected: "accountId=123456789012", Actual: "accountId=210987654321"},
+	}))
+}
+
+func TestDetectDriftMasksSecrets(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	gcp := node(londonID, "gcp", "GCP-FRA-1G", models.AdministrativeStateDeployed)
+	gcp.Type = models.NodeTypeCloud
+	gcp.ProviderConfig = &models.ProviderCloudConfig{PairingKey: "live-pairing-key"}
+
+	routeLiveWorkspace(server, gh, []models.Node{gcp}, nil, nil)
+
+	report, err := DetectDrift(context.Background(), client, workspaceID, &WorkspaceSpec{
+		Nodes: []NodeSpec{
+			{Name: "gcp", Type: models.NodeTypeCloud, SKU: "GCP-FRA-1G", ProviderConfig: &models.ProviderCloudConfig{PairingKey: "desired-pairing-key"}},
+		},
+	})
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(report.Drifts[0].Fields).Should(Equal([]FieldDrift{
+		{Field: "providerConfig", Expected: "pairingKey=<masked>", Actual: "pairingKey=<masked>"},
+	}))
+	encoded, err := report.JSON()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(string(encoded)).ShouldNot(ContainSubstring("pairing-key"))
+	g.Expect(report.String()).ShouldNot(ContainSubstring("pairing-key"))
+}
+
+func TestDetectDriftNone(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	routeLiveWorkspace(server, gh,
+		[]models.Node{node(parisID, "paris", "ACCESS-PAR-1G", models.AdministrativeStateDeployed)},
+		nil,
+		nil,
+	)
+
+	report, err := DetectDrift(context.Background(), client, workspaceID, &WorkspaceSpec{
+		Nodes: []NodeSpec{{Name: "paris", Type: models.NodeTypeAccess, SKU: "ACCESS-PAR-1G"}},
+	})
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(report.HasDrift()).Should(BeFalse())
+	g.Expect(report.String()).Should(Equal("workspace " + workspaceID + ": no drift"))
+}
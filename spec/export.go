@@ -0,0 +1,124 @@
+package spec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+var ErrMaskedSecret = errors.New("provider config contains a masked secret")
+
+// ExportWorkspace builds the spec of a live workspace, so that it can be stored and applied to
+// recreate the same topology elsewhere. The pairing and service keys of the cloud nodes are masked.
+// Attachments whose node or transport is being deleted are not exported.
+func ExportWorkspace(ctx context.Context, client *autonomisdk.Client, workspaceID string) (*WorkspaceSpec, error) {
+	l, err := fetchLive(ctx, client, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &WorkspaceSpec{
+		WorkspaceID: workspaceID,
+	}
+
+	nodeNames := map[string]string{}
+	for name, node := range l.nodes {
+		nodeNames[node.ID.String()] = name
+		nodeSpec := NodeSpec{
+			Name:           name,
+			Type:           node.Type,
+			SKU:            node.Product.SKU,
+			ProviderConfig: maskProviderConfig(node.ProviderConfig),
+			Vlan:           node.Vlan,
+		}
+		if node.PhysicalPort != nil {
+			nodeSpec.PhysicalPortID = node.PhysicalPort.ID.String()
+		}
+		spec.Nodes = append(spec.Nodes, nodeSpec)
+	}
+
+	transportNames := map[string]string{}
+	for name, transport := range l.transports {
+		transportNames[transport.ID.String()] = name
+		transportSpec := TransportSpec{
+			Name: name,
+			SKU:  transport.Product.SKU,
+		}
+		if vlans := transport.TransportVlans; vlans != (models.TransportVlans{}) {
+			transportSpec.Vlans = &vlans
+		}
+		spec.Transports = append(spec.Transports, transportSpec)
+	}
+
+	for _, attachment := range l.attachments {
+		nodeName, nodeFound := nodeNames[attachment.NodeID]
+		transportName, transportFound := transportNames[attachment.TransportID]
+		if !nodeFound || !transportFound {
+			continue
+		}
+		spec.Attachments = append(spec.Attachments, AttachmentSpec{
+			Node:      nodeName,
+			Transport: transportName,
+			Side:      attachment.Side,
+		})
+	}
+
+	// map iteration order is random, sort the elements to get a stable export
+	slices.SortFunc(spec.Nodes, func(a, b NodeSpec) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(spec.Transports, func(a, b TransportSpec) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(spec.Attachments, func(a, b AttachmentSpec) int { return strings.Compare(a.Name(), b.Name()) })
+
+	return spec, nil
+}
+
+// JSON returns the spec encoded in indented JSON.
+func (s *WorkspaceSpec) JSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+// YAML returns the spec encoded in YAML, with the same field names as in JSON.
+func (s *WorkspaceSpec) YAML() ([]byte, error) {
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is valid YAML: decoding it into a node keeps the order of the fields
+	document := yaml.Node{}
+	if err := yaml.Unmarshal(raw, &document); err != nil {
+		return nil, err
+	}
+	resetStyle(&document)
+
+	out := new(bytes.Buffer)
+	encoder := yaml.NewEncoder(out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+// resetStyle drops the flow style and quotes inherited from JSON so that the document is written in block style.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+func hasMaskedSecret(config *models.ProviderCloudConfig) bool {
+	return config != nil && (config.PairingKey == MaskedSecret || config.ServiceKey == MaskedSecret || config.AccountID == MaskedSecret)
+}
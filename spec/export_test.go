@@ -0,0 +1,119 @@
+package spec
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+func TestExportWorkspace(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	portID := uuid.MustParse("2f3a1c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d")
+	paris := node(parisID, "paris", "ACCESS-PAR-1G", models.AdministrativeStateDeployed)
+	paris.Vlan = 100
+	paris.PhysicalPort = &models.PhysicalPort{BaseModel: models.BaseModel{ID: portID}}
+	azure := node(londonID, "azure", "AZURE-AMS-1G", models.AdministrativeStateDeployed)
+	azure.Type = models.NodeTypeCloud
+	azure.ProviderConfig = &models.ProviderCloudConfig{ServiceKey: "2a7b1f0e-3c4d-4e5f-8a9b-0c1d2e3f4a5b"}
+	backbone := transport(backboneID, "backbone", "TRANSPORT-1G", models.AdministrativeStateDeployed)
+	backbone.TransportVlans = models.TransportVlans{AVlan: 100}
+	link := attachment(newLinkID, parisID, backboneID, models.AdministrativeStateDeployed)
+	link.Side = models.AttachmentSideA
+
+	routeLiveWorkspace(server, gh,
+		[]models.Node{paris, azure, node(oldID, "old", "ACCESS-PAR-1G", models.AdministrativeStateDeletePending)},
+		[]models.Transport{backbone},
+		[]models.Attachment{
+			link,
+			attachment(oldLinkID, azure.ID, backboneID, models.AdministrativeStateDeleteProceed),
+			// the node of this attachment is being deleted, it cannot be referenced by name
+			attachment(uuid.New(), oldID, backboneID, models.AdministrativeStateDeployed),
+		},
+	)
+
+	spec, err := ExportWorkspace(context.Background(), client, workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(spec).Should(Equal(&WorkspaceSpec{
+		WorkspaceID: workspaceID,
+		Nodes: []NodeSpec{
+			{Name: "azure", Type: models.NodeTypeCloud, SKU: "AZURE-AMS-1G", ProviderConfig: &models.ProviderCloudConfig{ServiceKey: MaskedSecret}},
+			{Name: "paris", Type: models.NodeTypeAccess, SKU: "ACCESS-PAR-1G", PhysicalPortID: portID.String(), Vlan: 100},
+		},
+		Transports: []TransportSpec{
+			{Name: "backbone", SKU: "TRANSPORT-1G", Vlans: &models.TransportVlans{AVlan: 100}},
+		},
+		Attachments: []AttachmentSpec{
+			{Node: "paris", Transport: "backbone", Side: models.AttachmentSideA},
+		},
+	}))
+	// the secret of the live node must not be altered
+	g.Expect(azure.ProviderConfig.ServiceKey).Should(Equal("2a7b1f0e-3c4d-4e5f-8a9b-0c1d2e3f4a5b"))
+
+	out, err := spec.YAML()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(string(out)).Should(Equal(`workspaceId: ` + workspaceID + `
+nodes:
+  - name: azure
+    type: cloud
+    sku: AZURE-AMS-1G
+    providerConfig:
+      serviceKey: <masked>
+  - name: paris
+    type: access
+    sku: ACCESS-PAR-1G
+    physicalPortId: ` + portID.String() + `
+    vlan: 100
+transports:
+  - name: backbone
+    sku: TRANSPORT-1G
+    vlans:
+      aVlan: 100
+attachments:
+  - node: paris
+    transport: backbone
+    side: A
+`))
+
+	// an exported spec can be loaded back, in YAML as in JSON
+	loaded, err := Load(bytes.NewReader(out))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(loaded).Should(Equal(spec))
+
+	out, err = spec.JSON()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	loaded, err = Load(bytes.NewReader(out))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(loaded).Should(Equal(spec))
+
+	// the exported spec does not drift from the workspace it was exported from
+	report, err := DetectDrift(context.Background(), client, workspaceID, spec)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(report.Drifts).Should(HaveLen(1))
+	g.Expect(report.Drifts[0].Kind).Should(Equal(DriftKindUnexpected))
+	g.Expect(report.Drifts[0].ElementKind).Should(Equal(models.ElementKindAttachment))
+}
+
+func TestApplyMaskedSecret(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	routeLiveWorkspace(server, gh, nil, nil, nil)
+
+	plan, err := Plan(context.Background(), client, &WorkspaceSpec{
+		WorkspaceID: workspaceID,
+		Nodes: []NodeSpec{
+			{Name: "azure", Type: models.NodeTypeCloud, SKU: "AZURE-AMS-1G", ProviderConfig: &models.ProviderCloudConfig{ServiceKey: MaskedSecret}},
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	err = Apply(context.Background(), client, plan)
+
+	g.Expect(err).Should(MatchError(ErrMaskedSecret))
+}
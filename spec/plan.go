@@ -0,0 +1,525 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+var (
+	ErrAmbiguousName   = errors.New("several live elements share the same name")
+	ErrImmutableChange = errors.New("attribute cannot be changed in place")
+	ErrUnknownElement  = errors.New("element referenced by the plan is unknown")
+	ErrIncompletePlan  = errors.New("plan was not built by Plan")
+)
+
+const (
+	fieldType           = "type"
+	fieldSKU            = "sku"
+	fieldVlan           = "vlan"
+	fieldVlans          = "vlans"
+	fieldSide           = "side"
+	fieldPhysicalPortID = "physicalPortId"
+	fieldProviderConfig = "providerConfig"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// Diff is an attribute whose live value differs from the spec.
+type Diff struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+func (d Diff) String() string {
+	return fmt.Sprintf("%s: %s -> %s", d.Field, d.From, d.To)
+}
+
+// Change is a single operation of a plan.
+type Change struct {
+	Action Action             `json:"action"`
+	Kind   models.ElementKind `json:"kind"`
+	Name   string             `json:"name"`
+	// ID is the id of the live element, empty for a creation.
+	ID    string `json:"id,omitempty"`
+	Diffs []Diff `json:"diffs,omitempty"`
+
+	node       *NodeSpec
+	transport  *TransportSpec
+	attachment *AttachmentSpec
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s '%s'", c.Action, c.Kind, c.Name)
+	if len(c.Diffs) > 0 {
+		diffs := make([]string, 0, len(c.Diffs))
+		for _, diff := range c.Diffs {
+			diffs = append(diffs, diff.String())
+		}
+		s += " (" + strings.Join(diffs, ", ") + ")"
+	}
+
+	return s
+}
+
+func (c Change) diff(field string) (Diff, bool) {
+	for _, diff := range c.Diffs {
+		if diff.Field == field {
+			return diff, true
+		}
+	}
+
+	return Diff{}, false
+}
+
+// WorkspacePlan lists the changes to apply to a workspace to make it match its spec.
+// The changes are sorted in the order they must be applied. A plan encoded in JSON can be
+// reviewed but does not hold the specs of the elements: once decoded, or when built by hand,
+// only its deletions can be applied.
+type WorkspacePlan struct {
+	WorkspaceID string   `json:"workspaceId"`
+	Changes     []Change `json:"changes"`
+
+	// nodeIDs and transportIDs map the names of the live elements to their ids.
+	nodeIDs      map[string]string
+	transportIDs map[string]string
+}
+
+// Empty reports whether the workspace already matches its spec.
+func (p *WorkspacePlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+func (p *WorkspacePlan) String() string {
+	if p.Empty() {
+		return "No changes."
+	}
+
+	lines := make([]string, 0, len(p.Changes))
+	for _, change := range p.Changes {
+		lines = append(lines, change.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// live holds the elements of a workspace which are not being deleted, indexed by name.
+type live struct {
+	nodes       map[string]models.Node
+	transports  map[string]models.Transport
+	attachments map[string]models.Attachment
+}
+
+// fetchLive lists the elements of the workspace and indexes them by name.
+func fetchLive(ctx context.Context, client *autonomisdk.Client, workspaceID string) (*live, error) {
+	inventory, err := client.GetWorkspaceInventory(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := inventory.Err(); err != nil {
+		return nil, err
+	}
+
+	l := &live{
+		nodes:       map[string]models.Node{},
+		transports:  map[string]models.Transport{},
+		attachments: map[string]models.Attachment{},
+	}
+
+	nodeNames := map[string]string{}
+	for _, node := range inventory.Nodes {
+		if node.State.IsDeleting() {
+			continue
+		}
+		if _, found := l.nodes[node.Name]; found {
+			return nil, fmt.Errorf("%w: node '%s'", ErrAmbiguousName, node.Name)
+		}
+		l.nodes[node.Name] = node
+		nodeNames[node.ID.String()] = node.Name
+	}
+
+	transportNames := map[string]string{}
+	for _, transport := range inventory.Transports {
+		if transport.State.IsDeleting() {
+			continue
+		}
+		if _, found := l.transports[transport.Name]; found {
+			return nil, fmt.Errorf("%w: transport '%s'", ErrAmbiguousName, transport.Name)
+		}
+		l.transports[transport.Name] = transport
+		transportNames[transport.ID.String()] = transport.Name
+	}
+
+	for _, attachment := range inventory.Attachments {
+		if attachment.State.IsDeleting() {
+			continue
+		}
+		// an attachment linking an element unknown by name is indexed by its id so that it gets deleted
+		name := attachment.ID.String()
+		nodeName, nodeFound := nodeNames[attachment.NodeID]
+		transportName, transportFound := transportNames[attachment.TransportID]
+		if nodeFound && transportFound {
+			name = attachmentName(nodeName, transportName)
+		}
+		l.attachments[name] = attachment
+	}
+
+	return l, nil
+}
+
+// Plan compares the spec to the live workspace and lists the changes needed to converge it.
+func Plan(ctx context.Context, client *autonomisdk.Client, spec *WorkspaceSpec) (*WorkspacePlan, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	l, err := fetchLive(ctx, client, spec.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &WorkspacePlan{
+		WorkspaceID:  spec.WorkspaceID,
+		nodeIDs:      map[string]string{},
+		transportIDs: map[string]string{},
+	}
+	for name, node := range l.nodes {
+		plan.nodeIDs[name] = node.ID.String()
+	}
+	for name, transport := range l.transports {
+		plan.transportIDs[name] = transport.ID.String()
+	}
+
+	var (
+		attachmentDeletes, transportDeletes, nodeDeletes []Change
+		nodeCreates, transportCreates, attachmentCreates []Change
+		nodeUpdates, transportUpdates                    []Change
+	)
+
+	wantedNodes := map[string]bool{}
+	for i := range spec.Nodes {
+		// the specs are copied so that the plan is not altered by later edits of the spec, which skip Validate
+		node := spec.Nodes[i].clone()
+		wantedNodes[node.Name] = true
+		current, found := l.nodes[node.Name]
+		if !found {
+			nodeCreates = append(nodeCreates, Change{Action: ActionCreate, Kind: models.ElementKindNode, Name: node.Name, node: node})
+			continue
+		}
+		if diffs := immutableNodeDiffs(*node, current); len(diffs) > 0 {
+			return nil, fmt.Errorf("%w: %s of node '%s' (%s -> %s)", ErrImmutableChange, diffs[0].Field, node.Name, diffs[0].From, diffs[0].To)
+		}
+		if current.Product.SKU != node.SKU {
+			nodeUpdates = append(nodeUpdates, Change{
+				Action: ActionUpdate,
+				Kind:   models.ElementKindNode,
+				Name:   node.Name,
+				ID:     current.ID.String(),
+				Diffs:  []Diff{{Field: fieldSKU, From: current.Product.SKU, To: node.SKU}},
+				node:   node,
+			})
+		}
+	}
+	for name, node := range l.nodes {
+		if !wantedNodes[name] {
+			nodeDeletes = append(nodeDeletes, Change{Action: ActionDelete, Kind: models.ElementKindNode, Name: name, ID: node.ID.String()})
+		}
+	}
+
+	wantedTransports := map[string]bool{}
+	for i := range spec.Transports {
+		transport := spec.Transports[i].clone()
+		wantedTransports[transport.Name] = true
+		current, found := l.transports[transport.Name]
+		if !found {
+			transportCreates = append(transportCreates, Change{Action: ActionCreate, Kind: models.ElementKindTransport, Name: transport.Name, transport: transport})
+			continue
+		}
+		diffs := []Diff{}
+		if current.Product.SKU != transport.SKU {
+			diffs = append(diffs, Diff{Field: fieldSKU, From: current.Product.SKU, To: transport.SKU})
+		}
+		// the sides left empty in the spec keep their live VLAN, which is sent again in the update
+		if vlans := mergeVlans(transport.Vlans, current.TransportVlans); vlans != current.TransportVlans {
+			diffs = append(diffs, Diff{Field: fieldVlans, From: formatVlans(current.TransportVlans), To: formatVlans(vlans)})
+			transport.Vlans = &vlans
+		}
+		if len(diffs) > 0 {
+			transportUpdates = append(transportUpdates, Change{
+				Action:    ActionUpdate,
+				Kind:      models.ElementKindTransport,
+				Name:      transport.Name,
+				ID:        current.ID.String(),
+				Diffs:     diffs,
+				transport: transport,
+			})
+		}
+	}
+	for name, transport := range l.transports {
+		if !wantedTransports[name] {
+			transportDeletes = append(transportDeletes, Change{Action: ActionDelete, Kind: models.ElementKindTransport, Name: name, ID: transport.ID.String()})
+		}
+	}
+
+	wantedAttachments := map[string]bool{}
+	for i := range spec.Attachments {
+		attachment := spec.Attachments[i]
+		current, found := l.attachments[attachment.Name()]
+		if found && attachment.Side != "" && current.Side != attachment.Side {
+			// the side of an attachment cannot be updated, the attachment is replaced
+			attachmentDeletes = append(attachmentDeletes, Change{
+				Action: ActionDelete,
+				Kind:   models.ElementKindAttachment,
+				Name:   attachment.Name(),
+				ID:     current.ID.String(),
+				Diffs:  []Diff{{Field: fieldSide, From: current.Side.String(), To: attachment.Side.String()}},
+			})
+			found = false
+		}
+		wantedAttachments[attachment.Name()] = found
+		if !found {
+			attachmentCreates = append(attachmentCreates, Change{Action: ActionCreate, Kind: models.ElementKindAttachment, Name: attachment.Name(), attachment: &attachment})
+		}
+	}
+	for name, attachment := range l.attachments {
+		if _, wanted := wantedAttachments[name]; !wanted {
+			attachmentDeletes = append(attachmentDeletes, Change{Action: ActionDelete, Kind: models.ElementKindAttachment, Name: name, ID: attachment.ID.String()})
+		}
+	}
+
+	// map iteration order is random, sort the deletions to get a stable plan
+	for _, changes := range [][]Change{attachmentDeletes, transportDeletes, nodeDeletes} {
+		sortChanges(changes)
+	}
+
+	plan.Changes = append(plan.Changes, attachmentDeletes...)
+	plan.Changes = append(plan.Changes, transportDeletes...)
+	plan.Changes = append(plan.Changes, nodeDeletes...)
+	plan.Changes = append(plan.Changes, nodeCreates...)
+	plan.Changes = append(plan.Changes, transportCreates...)
+	plan.Changes = append(plan.Changes, nodeUpdates...)
+	plan.Changes = append(plan.Changes, transportUpdates...)
+	plan.Changes = append(plan.Changes, attachmentCreates...)
+
+	return plan, nil
+}
+
+func (ns NodeSpec) clone() *NodeSpec {
+	if ns.ProviderConfig != nil {
+		config := *ns.ProviderConfig
+		ns.ProviderConfig = &config
+	}
+
+	return &ns
+}
+
+func (ts TransportSpec) clone() *TransportSpec {
+	if ts.Vlans != nil {
+		vlans := *ts.Vlans
+		ts.Vlans = &vlans
+	}
+
+	return &ts
+}
+
+// immutableNodeDiffs returns the drifts of a live node on the attributes which can only be set at creation.
+func immutableNodeDiffs(desired NodeSpec, current models.Node) []Diff {
+	diffs := []Diff{}
+	for _, field := range nodeDrift(desired, current) {
+		// the product is changed in place
+		if field.Field == fieldSKU {
+			continue
+		}
+		diffs = append(diffs, Diff{Field: field.Field, From: field.Actual, To: field.Expected})
+	}
+
+	return diffs
+}
+
+func physicalPortID(node models.Node) string {
+	if node.PhysicalPort == nil {
+		return ""
+	}
+
+	return node.PhysicalPort.ID.String()
+}
+
+// Apply executes the changes of the plan in order, waiting for each of them to be over before
+// starting the next one. It stops at the first failure, the changes already applied are kept.
+func Apply(ctx context.Context, client *autonomisdk.Client, plan *WorkspacePlan) error {
+	if err := plan.check(); err != nil {
+		return err
+	}
+
+	for _, change := range plan.Changes {
+		if err := plan.apply(ctx, client, change); err != nil {
+			return fmt.Errorf("cannot %s: %w", change, err)
+		}
+	}
+
+	return nil
+}
+
+// check makes sure every change holds what is needed to apply it, before any change is applied.
+func (p *WorkspacePlan) check() error {
+	for _, change := range p.Changes {
+		if change.ID == "" && change.Action != ActionCreate {
+			return fmt.Errorf("%w: %s has no id", ErrIncompletePlan, change)
+		}
+		if change.Action == ActionDelete {
+			continue
+		}
+		missing := p.nodeIDs == nil || p.transportIDs == nil
+		switch change.Kind {
+		case models.ElementKindNode:
+			missing = missing || change.node == nil
+		case models.ElementKindTransport:
+			missing = missing || change.transport == nil
+		case models.ElementKindAttachment:
+			missing = missing || change.attachment == nil
+		}
+		if missing {
+			return fmt.Errorf("%w: %s", ErrIncompletePlan, change)
+		}
+	}
+
+	return nil
+}
+
+func (p *WorkspacePlan) apply(ctx context.Context, client *autonomisdk.Client, change Change) error {
+	switch {
+	case change.Action == ActionDelete && change.Kind == models.ElementKindAttachment:
+		_, err := client.DeleteAttachment(ctx, p.WorkspaceID, change.ID, autonomisdk.WithWaitUntilElementUndeployed())
+		return err
+
+	case change.Action == ActionDelete && change.Kind == models.ElementKindTransport:
+		if _, err := client.DeleteTransport(ctx, p.WorkspaceID, change.ID, autonomisdk.WithWaitUntilElementUndeployed()); err != nil {
+			return err
+		}
+		delete(p.transportIDs, change.Name)
+
+	case change.Action == ActionDelete && change.Kind == models.ElementKindNode:
+		if _, err := client.DeleteNode(ctx, p.WorkspaceID, change.ID, autonomisdk.WithWaitUntilElementUndeployed()); err != nil {
+			return err
+		}
+		delete(p.nodeIDs, change.Name)
+
+	case change.Action == ActionCreate && change.Kind == models.ElementKindNode:
+		if hasMaskedSecret(change.node.ProviderConfig) {
+			return ErrMaskedSecret
+		}
+		payload := models.CreateNode{
+			Name:           change.node.Name,
+			Type:           change.node.Type,
+			Product:        models.AddProduct{SKU: change.node.SKU},
+			ProviderConfig: change.node.ProviderConfig,
+			Vlan:           change.node.Vlan,
+		}
+		if change.node.PhysicalPortID != "" {
+			portID, err := uuid.Parse(change.node.PhysicalPortID)
+			if err != nil {
+				return fmt.Errorf("invalid physical port id: %w", err)
+			}
+			payload.PhysicalPortID = &portID
+		}
+		node, err := client.CreateNode(ctx, payload, p.WorkspaceID, autonomisdk.WithWaitUntilElementDeployed())
+		if err != nil {
+			return err
+		}
+		p.nodeIDs[change.Name] = node.ID.String()
+
+	case change.Action == ActionCreate && change.Kind == models.ElementKindTransport:
+		payload := models.CreateTransport{
+			Name:    change.transport.Name,
+			Product: models.AddProduct{SKU: change.transport.SKU},
+			Vlans:   change.transport.Vlans,
+		}
+		transport, err := client.CreateTransport(ctx, payload, p.WorkspaceID, autonomisdk.WithWaitUntilElementDeployed())
+		if err != nil {
+			return err
+		}
+		p.transportIDs[change.Name] = transport.ID.String()
+
+	case change.Action == ActionUpdate && change.Kind == models.ElementKindNode:
+		payload := models.UpdateElementProduct{Product: models.AddProduct{SKU: change.node.SKU}}
+		_, err := client.UpdateNodeProduct(ctx, payload, p.WorkspaceID, change.ID, autonomisdk.WithWaitUntilElementDeployed())
+		return err
+
+	case change.Action == ActionUpdate && change.Kind == models.ElementKindTransport:
+		if _, found := change.diff(fieldSKU); found {
+			payload := models.UpdateElementProduct{Product: models.AddProduct{SKU: change.transport.SKU}}
+			if _, err := client.UpdateTransportProduct(ctx, payload, p.WorkspaceID, change.ID, autonomisdk.WithWaitUntilElementDeployed()); err != nil {
+				return err
+			}
+		}
+		if _, found := change.diff(fieldVlans); found {
+			payload := models.UpdateTransportVlans{Vlans: *change.transport.Vlans}
+			if _, err := client.UpdateTransportVlans(ctx, payload, p.WorkspaceID, change.ID); err != nil {
+				return err
+			}
+		}
+
+	case change.Action == ActionCreate && change.Kind == models.ElementKindAttachment:
+		nodeID, found := p.nodeIDs[change.attachment.Node]
+		if !found {
+			return fmt.Errorf("%w: node '%s'", ErrUnknownElement, change.attachment.Node)
+		}
+		transportID, found := p.transportIDs[change.attachment.Transport]
+		if !found {
+			return fmt.Errorf("%w: transport '%s'", ErrUnknownElement, change.attachment.Transport)
+		}
+		payload := models.CreateAttachment{
+			NodeID:      nodeID,
+			TransportID: transportID,
+			Side:        change.attachment.Side,
+		}
+		_, err := client.CreateAttachment(ctx, payload, p.WorkspaceID, autonomisdk.WithWaitUntilElementDeployed())
+		return err
+	}
+
+	return nil
+}
+
+// mergeVlans returns the live VLANs of a transport overridden by the sides set in the spec.
+func mergeVlans(desired *models.TransportVlans, current models.TransportVlans) models.TransportVlans {
+	merged := current
+	if desired == nil {
+		return merged
+	}
+	if desired.AVlan != 0 {
+		merged.AVlan = desired.AVlan
+	}
+	if desired.ZVlan != 0 {
+		merged.ZVlan = desired.ZVlan
+	}
+
+	return merged
+}
+
+func formatVlans(vlans models.TransportVlans) string {
+	return fmt.Sprintf("A=%d Z=%d", vlans.AVlan, vlans.ZVlan)
+}
+
+func sortChanges(changes []Change) {
+	slices.SortFunc(changes, func(a, b Change) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
@@ -0,0 +1,456 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+const (
+	accountID   = "e1b4ff0f-8ab1-4b2e-a0b4-2fb8e6c0b1c2"
+	workspaceID = "84d2e5a6-3f14-4b9a-9d5c-1e6f0a2b7c3d"
+)
+
+var (
+	parisID    = uuid.MustParse("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d")
+	londonID   = uuid.MustParse("1b2c3d4e-5f6a-4b7c-9d8e-0f1a2b3c4d5e")
+	oldID      = uuid.MustParse("2c3d4e5f-6a7b-4c8d-8e9f-1a2b3c4d5e6f")
+	backboneID = uuid.MustParse("3d4e5f6a-7b8c-4d9e-9f0a-2b3c4d5e6f7a")
+	oldLinkID  = uuid.MustParse("4e5f6a7b-8c9d-4e0f-8a1b-3c4d5e6f7a8b")
+	newLinkID  = uuid.MustParse("5f6a7b8c-9d0e-4f1a-9b2c-4d5e6f7a8b9c")
+)
+
+func setupClient(t *testing.T) (*WithT, *ghttp.GHTTPWithGomega, *ghttp.Server, *autonomisdk.Client) {
+	g := NewWithT(t)
+	gh := ghttp.NewGHTTPWithGomega(g)
+	server := ghttp.NewServer()
+	t.Cleanup(server.Close)
+
+	server.AppendHandlers(
+		gh.RespondWithJSONEncoded(http.StatusOK, models.Self{AccountID: uuid.MustParse(accountID)}),
+	)
+
+	hostURL, err := url.Parse(server.URL())
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	client, err := autonomisdk.NewClient(
+		true,
+		autonomisdk.WithHostURL(hostURL),
+		autonomisdk.WithPersonalAccessToken("token"),
+		autonomisdk.WithPolling(10*time.Millisecond, 5),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return g, gh, server, client
+}
+
+func workspacePath(elements ...string) string {
+	path := fmt.Sprintf("/accounts/%s/workspaces/%s", accountID, workspaceID)
+	for _, element := range elements {
+		path += "/" + element
+	}
+
+	return path
+}
+
+func node(id uuid.UUID, name, sku string, state models.AdministrativeState) models.Node {
+	return models.Node{
+		BaseModel: models.BaseModel{ID: id},
+		Name:      name,
+		Type:      models.NodeTypeAccess,
+		State:     state,
+		Product:   models.NodeProduct{Product: models.Product{SKU: sku}},
+	}
+}
+
+func transport(id uuid.UUID, name, sku string, state models.AdministrativeState) models.Transport {
+	return models.Transport{
+		BaseModel: models.BaseModel{ID: id},
+		Name:      name,
+		State:     state,
+		Product:   models.TransportProduct{Product: models.Product{SKU: sku}},
+	}
+}
+
+func attachment(id uuid.UUID, nodeID, transportID uuid.UUID, state models.AdministrativeState) models.Attachment {
+	return models.Attachment{
+		BaseModel:   models.BaseModel{ID: id},
+		NodeID:      nodeID.String(),
+		TransportID: transportID.String(),
+		State:       state,
+	}
+}
+
+// routeLiveWorkspace routes the requests listing the live workspace, sent concurrently.
+func routeLiveWorkspace(server *ghttp.Server, gh *ghttp.GHTTPWithGomega, nodes []models.Node, transports []models.Transport, attachments []models.Attachment) {
+	server.RouteToHandler(http.MethodGet, workspacePath(),
+		gh.RespondWithJSONEncoded(http.StatusOK, models.WorkspaceResponse{Data: models.Workspace{Name: "workspace"}}),
+	)
+	server.RouteToHandler(http.MethodGet, workspacePath("nodes"),
+		gh.RespondWithJSONEncoded(http.StatusOK, models.NodesResponse{Data: nodes}),
+	)
+	server.RouteToHandler(http.MethodGet, workspacePath("transports"),
+		gh.RespondWithJSONEncoded(http.StatusOK, models.TransportsResponse{Data: transports}),
+	)
+	server.RouteToHandler(http.MethodGet, workspacePath("attachments"),
+		gh.RespondWithJSONEncoded(http.StatusOK, models.AttachmentsResponse{Data: attachments}),
+	)
+}
+
+func TestPlan(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	routeLiveWorkspace(server, gh,
+		[]models.Node{
+			node(parisID, "paris", "ACCESS-PAR-1G", models.AdministrativeStateDeployed),
+			node(oldID, "old", "ACCESS-PAR-1G", models.AdministrativeStateDeployed),
+			node(uuid.New(), "gone", "ACCESS-PAR-1G", models.AdministrativeStateDeleteProceed),
+		},
+		[]models.Transport{
+			transport(backboneID, "backbone", "TRANSPORT-1G", models.AdministrativeStateDeployed),
+		},
+		[]models.Attachment{
+			attachment(oldLinkID, oldID, backboneID, models.AdministrativeStateDeployed),
+		},
+	)
+
+	spec := &WorkspaceSpec{
+		WorkspaceID: workspaceID,
+		Nodes: []NodeSpec{
+			{Name: "paris", Type: models.NodeTypeAccess, SKU: "ACCESS-PAR-10G"},
+			{Name: "london", Type: models.NodeTypeAccess, SKU: "ACCESS-LON-1G"},
+		},
+		Transports: []TransportSpec{
+			{Name: "backbone", SKU: "TRANSPORT-1G", Vlans: &models.TransportVlans{AVlan: 100, ZVlan: 200}},
+		},
+		Attachments: []AttachmentSpec{
+			{Node: "paris", Transport: "backbone"},
+			{Node: "london", Transport: "backbone"},
+		},
+	}
+
+	plan, err := Plan(context.Background(), client, spec)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(plan.Empty()).Should(BeFalse())
+	g.Expect(plan.String()).Should(Equal(`delete attachment 'old/backbone'
+delete node 'old'
+create node 'london'
+update node 'paris' (sku: ACCESS-PAR-1G -> ACCESS-PAR-10G)
+update transport 'backbone' (vlans: A=0 Z=0 -> A=100 Z=200)
+create attachment 'paris/backbone'
+create attachment 'london/backbone'`))
+}
+
+func TestPlanWithoutChanges(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	routeLiveWorkspace(server, gh,
+		[]models.Node{node(parisID, "paris", "ACCESS-PAR-1G", models.AdministrativeStateDeployed)},
+		nil,
+		nil,
+	)
+
+	plan, err := Plan(context.Background(), client, &WorkspaceSpec{
+		WorkspaceID: workspaceID,
+		Nodes:       []NodeSpec{{Name: "paris", Type: models.NodeTypeAccess, SKU: "ACCESS-PAR-1G"}},
+	})
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(plan.Empty()).Should(BeTrue())
+	g.Expect(plan.String()).Should(Equal("No changes."))
+}
+
+func TestPlanImmutableChange(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	routeLiveWorkspace(server, gh,
+		[]models.Node{node(parisID, "paris", "ACCESS-PAR-1G", models.AdministrativeStateDeployed)},
+		nil,
+		nil,
+	)
+
+	plan, err := Plan(context.Background(), client, &WorkspaceSpec{
+		WorkspaceID: workspaceID,
+		Nodes:       []NodeSpec{{Name: "paris", Type: models.NodeTypeRouter, SKU: "ACCESS-PAR-1G"}},
+	})
+
+	g.Expect(err).Should(MatchError(ErrImmutableChange))
+	g.Expect(plan).Should(BeNil())
+}
+
+func TestPlanImmutableNodeAttributes(t *testing.T) {
+	portID := uuid.MustParse("6a7b8c9d-0e1f-4a2b-8c3d-5e6f7a8b9c0d")
+	current := node(parisID, "paris", "ACCESS-PAR-1G", models.AdministrativeStateDeployed)
+	current.Vlan = 100
+	current.PhysicalPort = &models.PhysicalPort{BaseModel: models.BaseModel{ID: portID}}
+	current.ProviderConfig = &models.ProviderCloudConfig{AccountID: "123456789012"}
+
+	tests := []struct {
+		name  string
+		spec  NodeSpec
+		error string
+	}{
+		{
+			name:  "vlan",
+			spec:  NodeSpec{Vlan: 200},
+			error: "vlan of node 'paris' (100 -> 200)",
+		},
+		{
+			name:  "physical port",
+			spec:  NodeSpec{PhysicalPortID: oldID.String()},
+			error: fmt.Sprintf("physicalPortId of node 'paris' (%s -> %s)", portID, oldID),
+		},
+		{
+			name:  "provider config",
+			spec:  NodeSpec{ProviderConfig: &models.ProviderCloudConfig{AccountID: "210987654321"}},
+			error: "providerConfig of node 'paris' (accountId=123456789012 -> accountId=210987654321)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+		tc := tc
+		g, gh, server, client := setupClient(t)
+		routeLiveWorkspace(server, gh, []models.Node{current}, nil, nil)
+
+		spec := tc.spec
+		spec.Name, spec.Type, spec.SKU = "paris", models.NodeTypeAccess, "ACCESS-PAR-1G"
+		plan, err := Plan(context.Background(), client, &WorkspaceSpec{WorkspaceID: workspaceID, Nodes: []NodeSpec{spec}})
+
+		g.Expect(err).Should(MatchError(ErrImmutableChange))
+		g.Expect(err.Error()).Should(ContainSubstring(tc.error))
+		g.Expect(plan).Should(BeNil())
+	}
+}
+
+func TestPlanPartialTransportVlans(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	backbone := transport(backboneID, "backbone", "TRANSPORT-1G", models.AdministrativeStateDeployed)
+	backbone.TransportVlans = models.TransportVlans{AVlan: 100, ZVlan: 200}
+	routeLiveWorkspace(server, gh, nil, []models.Transport{backbone}, nil)
+
+	// no side set, nothing to change
+	plan, err := Plan(context.Background(), client, &WorkspaceSpec{
+		WorkspaceID: workspaceID,
+		Transports:  []TransportSpec{{Name: "backbone", SKU: "TRANSPORT-1G", Vlans: &models.TransportVlans{}}},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(plan.Empty()).Should(BeTrue())
+
+	// only the A side is set, the Z side keeps its live VLAN
+	plan, err = Plan(context.Background(), client, &WorkspaceSpec{
+		WorkspaceID: workspaceID,
+		Transports:  []TransportSpec{{Name: "backbone", SKU: "TRANSPORT-1G", Vlans: &models.TransportVlans{AVlan: 150}}},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(plan.String()).Should(Equal("update transport 'backbone' (vlans: A=100 Z=200 -> A=150 Z=200)"))
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPatch, workspacePath("transports", backboneID.String())),
+			gh.VerifyJSONRepresenting(models.UpdateTransportVlans{Vlans: models.TransportVlans{AVlan: 150, ZVlan: 200}}),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.TransportResponse{Data: backbone}),
+		),
+	)
+
+	err = Apply(context.Background(), client, plan)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestPlanAmbiguousName(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	routeLiveWorkspace(server, gh,
+		[]models.Node{
+			node(parisID, "paris", "ACCESS-PAR-1G", models.AdministrativeStateDeployed),
+			node(oldID, "paris", "ACCESS-PAR-1G", models.AdministrativeStateDeployed),
+		},
+		nil,
+		nil,
+	)
+
+	_, err := Plan(context.Background(), client, &WorkspaceSpec{WorkspaceID: workspaceID})
+
+	g.Expect(err).Should(MatchError(ErrAmbiguousName))
+}
+
+func TestApply(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	routeLiveWorkspace(server, gh,
+		[]models.Node{node(oldID, "old", "ACCESS-PAR-1G", models.AdministrativeStateDeployed)},
+		[]models.Transport{transport(backboneID, "backbone", "TRANSPORT-1G", models.AdministrativeStateDeployed)},
+		nil,
+	)
+
+	plan, err := Plan(context.Background(), client, &WorkspaceSpec{
+		WorkspaceID: workspaceID,
+		Nodes:       []NodeSpec{{Name: "london", Type: models.NodeTypeAccess, SKU: "ACCESS-LON-1G"}},
+		Transports:  []TransportSpec{{Name: "backbone", SKU: "TRANSPORT-1G"}},
+		Attachments: []AttachmentSpec{{Node: "london", Transport: "backbone"}},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	server.AppendHandlers(
+		// delete node old and wait until it is gone
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodDelete, workspacePath("nodes", oldID.String())),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.NodeResponse{Data: node(oldID, "old", "ACCESS-PAR-1G", models.AdministrativeStateDeletePending)}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, workspacePath("nodes", oldID.String())),
+			gh.RespondWithJSONEncoded(http.StatusNotFound, nil),
+		),
+		// create node london and wait until it is deployed
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPost, workspacePath("nodes")),
+			gh.VerifyJSONRepresenting(models.CreateNode{Name: "london", Type: models.NodeTypeAccess, Product: models.AddProduct{SKU: "ACCESS-LON-1G"}}),
+			gh.RespondWithJSONEncoded(http.StatusCreated, models.NodeResponse{Data: node(londonID, "london", "ACCESS-LON-1G", models.AdministrativeStateCreationPending)}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, workspacePath("nodes", londonID.String())),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.NodeResponse{Data: node(londonID, "london", "ACCESS-LON-1G", models.AdministrativeStateDeployed)}),
+		),
+		// attach the new node to the existing transport
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPost, workspacePath("attachments")),
+			gh.VerifyJSONRepresenting(models.CreateAttachment{NodeID: londonID.String(), TransportID: backboneID.String()}),
+			gh.RespondWithJSONEncoded(http.StatusCreated, models.AttachmentResponse{Data: attachment(newLinkID, londonID, backboneID, models.AdministrativeStateCreationPending)}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, workspacePath("attachments", newLinkID.String())),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.AttachmentResponse{Data: attachment(newLinkID, londonID, backboneID, models.AdministrativeStateDeployed)}),
+		),
+	)
+
+	err = Apply(context.Background(), client, plan)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestApplyStopsOnFailure(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	routeLiveWorkspace(server, gh, nil, nil, nil)
+
+	plan, err := Plan(context.Background(), client, &WorkspaceSpec{
+		WorkspaceID: workspaceID,
+		Nodes:       []NodeSpec{{Name: "london", Type: models.NodeTypeAccess, SKU: "ACCESS-LON-1G"}},
+		Transports:  []TransportSpec{{Name: "backbone", SKU: "TRANSPORT-1G"}},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPost, workspacePath("nodes")),
+			gh.RespondWithJSONEncoded(http.StatusBadRequest, nil),
+		),
+	)
+
+	err = Apply(context.Background(), client, plan)
+
+	g.Expect(err).Should(MatchError(ContainSubstring("cannot create node 'london': status: 400")))
+}
+
+func TestApplyDecodedPlan(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	routeLiveWorkspace(server, gh,
+		[]models.Node{node(oldID, "old", "ACCESS-PAR-1G", models.AdministrativeStateDeployed)},
+		nil,
+		nil,
+	)
+
+	plan, err := Plan(context.Background(), client, &WorkspaceSpec{
+		WorkspaceID: workspaceID,
+		Nodes:       []NodeSpec{{Name: "london", Type: models.NodeTypeAccess, SKU: "ACCESS-LON-1G"}},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	encoded, err := json.Marshal(plan)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	requests := len(server.ReceivedRequests())
+
+	// the spec of node london is lost, nothing is applied
+	decoded := &WorkspacePlan{}
+	g.Expect(json.Unmarshal(encoded, decoded)).Should(Succeed())
+	err = Apply(context.Background(), client, decoded)
+
+	g.Expect(err).Should(MatchError(ErrIncompletePlan))
+	g.Expect(err.Error()).Should(ContainSubstring("create node 'london'"))
+	g.Expect(server.ReceivedRequests()).Should(HaveLen(requests))
+
+	// a plan made of deletions only can be applied
+	decoded.Changes = decoded.Changes[:1]
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodDelete, workspacePath("nodes", oldID.String())),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.NodeResponse{Data: node(oldID, "old", "ACCESS-PAR-1G", models.AdministrativeStateDeletePending)}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, workspacePath("nodes", oldID.String())),
+			gh.RespondWithJSONEncoded(http.StatusNotFound, nil),
+		),
+	)
+
+	err = Apply(context.Background(), client, decoded)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestPlanIgnoresLaterSpecEdits(t *testing.T) {
+	g, gh, server, client := setupClient(t)
+
+	routeLiveWorkspace(server, gh, nil, nil, nil)
+
+	spec := &WorkspaceSpec{
+		WorkspaceID: workspaceID,
+		Nodes:       []NodeSpec{{Name: "london", Type: models.NodeTypeAccess, SKU: "ACCESS-LON-1G"}},
+	}
+	plan, err := Plan(context.Background(), client, spec)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// the spec is edited after being validated by Plan
+	spec.Nodes[0].SKU = "ACCESS-LON-10G"
+	spec.Nodes[0].PhysicalPortID = "not-a-uuid"
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPost, workspacePath("nodes")),
+			gh.VerifyJSONRepresenting(models.CreateNode{Name: "london", Type: models.NodeTypeAccess, Product: models.AddProduct{SKU: "ACCESS-LON-1G"}}),
+			gh.RespondWithJSONEncoded(http.StatusCreated, models.NodeResponse{Data: node(londonID, "london", "ACCESS-LON-1G", models.AdministrativeStateCreationPending)}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, workspacePath("nodes", londonID.String())),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.NodeResponse{Data: node(londonID, "london", "ACCESS-LON-1G", models.AdministrativeStateDeployed)}),
+		),
+	)
+
+	err = Apply(context.Background(), client, plan)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestApplyHandBuiltPlan(t *testing.T) {
+	g, _, _, client := setupClient(t)
+
+	err := Apply(context.Background(), client, &WorkspacePlan{
+		WorkspaceID: workspaceID,
+		Changes:     []Change{{Action: ActionCreate, Kind: models.ElementKindAttachment, Name: "london/backbone"}},
+	})
+
+	g.Expect(err).Should(MatchError(ErrIncompletePlan))
+}
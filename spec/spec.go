@@ -0,0 +1,170 @@
+// Package spec describes a workspace declaratively and converges the live workspace towards it.
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+var ErrInvalidSpec = errors.New("invalid workspace spec")
+
+// WorkspaceSpec is the desired content of a workspace. Nodes and transports are identified
+// by their name, which must be unique in the workspace, attachments by the names of the
+// node and of the transport they link.
+type WorkspaceSpec struct {
+	WorkspaceID string           `json:"workspaceId"`
+	Nodes       []NodeSpec       `json:"nodes,omitempty"`
+	Transports  []TransportSpec  `json:"transports,omitempty"`
+	Attachments []AttachmentSpec `json:"attachments,omitempty"`
+}
+
+type NodeSpec struct {
+	Name           string                      `json:"name"`
+	Type           models.NodeType             `json:"type"`
+	SKU            string                      `json:"sku"`
+	ProviderConfig *models.ProviderCloudConfig `json:"providerConfig,omitempty"`
+	PhysicalPortID string                      `json:"physicalPortId,omitempty"`
+	Vlan           int64                       `json:"vlan,omitempty"`
+}
+
+type TransportSpec struct {
+	Name  string                 `json:"name"`
+	SKU   string                 `json:"sku"`
+	Vlans *models.TransportVlans `json:"vlans,omitempty"`
+}
+
+type AttachmentSpec struct {
+	Node      string                `json:"node"`
+	Transport string                `json:"transport"`
+	Side      models.AttachmentSide `json:"side,omitempty"`
+}
+
+// Name returns the logical name of the attachment, made of the names of its node and transport.
+func (as AttachmentSpec) Name() string {
+	return attachmentName(as.Node, as.Transport)
+}
+
+func attachmentName(node, transport string) string {
+	return fmt.Sprintf("%s/%s", node, transport)
+}
+
+// Load reads a workspace spec written in YAML or JSON and validates it.
+// The YAML document is converted to JSON so that the json tags of the models apply to both formats.
+func Load(r io.Reader) (*WorkspaceSpec, error) {
+	var document any
+	if err := yaml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSpec, err)
+	}
+
+	raw, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSpec, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	spec := &WorkspaceSpec{}
+	if err := decoder.Decode(spec); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSpec, err)
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// LoadFile reads the workspace spec stored in the file path.
+func LoadFile(path string) (*WorkspaceSpec, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+// Validate checks the spec is complete and consistent before it is compared to the live workspace.
+func (s *WorkspaceSpec) Validate() error {
+	if s.WorkspaceID == "" {
+		return fmt.Errorf("%w: workspace id is required", ErrInvalidSpec)
+	}
+
+	nodes := map[string]bool{}
+	for _, node := range s.Nodes {
+		if node.Name == "" || node.SKU == "" {
+			return fmt.Errorf("%w: nodes require a name and a sku", ErrInvalidSpec)
+		}
+		if nodes[node.Name] {
+			return fmt.Errorf("%w: node '%s' is declared twice", ErrInvalidSpec, node.Name)
+		}
+		switch node.Type {
+		case models.NodeTypeAccess, models.NodeTypeCloud, models.NodeTypeBridge, models.NodeTypeRouter:
+		default:
+			return fmt.Errorf("%w: node '%s' has an unknown type '%s'", ErrInvalidSpec, node.Name, node.Type)
+		}
+		if node.Type == models.NodeTypeCloud && node.ProviderConfig == nil {
+			return fmt.Errorf("%w: cloud node '%s' requires a provider config", ErrInvalidSpec, node.Name)
+		}
+		if node.PhysicalPortID != "" {
+			if _, err := uuid.Parse(node.PhysicalPortID); err != nil {
+				return fmt.Errorf("%w: node '%s' has an invalid physical port id", ErrInvalidSpec, node.Name)
+			}
+		}
+		nodes[node.Name] = true
+	}
+
+	transports := map[string]bool{}
+	for _, transport := range s.Transports {
+		if transport.Name == "" || transport.SKU == "" {
+			return fmt.Errorf("%w: transports require a name and a sku", ErrInvalidSpec)
+		}
+		if transports[transport.Name] {
+			return fmt.Errorf("%w: transport '%s' is declared twice", ErrInvalidSpec, transport.Name)
+		}
+		if vlans := transport.Vlans; vlans != nil && (vlans.AVlan < 0 || vlans.AVlan > 4094 || vlans.ZVlan < 0 || vlans.ZVlan > 4094) {
+			return fmt.Errorf("%w: transport '%s' vlans must be between 1 and 4094", ErrInvalidSpec, transport.Name)
+		}
+		transports[transport.Name] = true
+	}
+
+	attachments := map[string]bool{}
+	sides := map[string]bool{}
+	for _, attachment := range s.Attachments {
+		if !nodes[attachment.Node] {
+			return fmt.Errorf("%w: attachment '%s' references an unknown node", ErrInvalidSpec, attachment.Name())
+		}
+		if !transports[attachment.Transport] {
+			return fmt.Errorf("%w: attachment '%s' references an unknown transport", ErrInvalidSpec, attachment.Name())
+		}
+		if attachments[attachment.Name()] {
+			return fmt.Errorf("%w: attachment '%s' is declared twice", ErrInvalidSpec, attachment.Name())
+		}
+		switch attachment.Side {
+		case "":
+		case models.AttachmentSideA, models.AttachmentSideZ:
+			side := attachment.Transport + "/" + attachment.Side.String()
+			if sides[side] {
+				return fmt.Errorf("%w: side %s of transport '%s' is used twice", ErrInvalidSpec, attachment.Side, attachment.Transport)
+			}
+			sides[side] = true
+		default:
+			return fmt.Errorf("%w: attachment '%s' has an unknown side '%s'", ErrInvalidSpec, attachment.Name(), attachment.Side)
+		}
+		attachments[attachment.Name()] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package spec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+const workspaceYAML = `
+workspaceId: 84d2e5a6-3f14-4b9a-9d5c-1e6f0a2b7c3d
+nodes:
+  - name: paris
+    type: access
+    sku: ACCESS-PAR-1G
+    physicalPortId: 2f3a1c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d
+    vlan: 100
+  - name: aws
+    type: cloud
+    sku: AWS-FRA-1G
+    providerConfig:
+      accountId: "123456789012"
+transports:
+  - name: backbone
+    sku: TRANSPORT-PAR-FRA-1G
+    vlans:
+      aVlan: 100
+attachments:
+  - node: paris
+    transport: backbone
+    side: A
+  - node: aws
+    transport: backbone
+`
+
+func TestLoad(t *testing.T) {
+	spec, err := Load(strings.NewReader(workspaceYAML))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "84d2e5a6-3f14-4b9a-9d5c-1e6f0a2b7c3d", spec.WorkspaceID)
+	assert.Len(t, spec.Nodes, 2)
+	assert.Equal(t, models.NodeTypeCloud, spec.Nodes[1].Type)
+	assert.Equal(t, "123456789012", spec.Nodes[1].ProviderConfig.AccountID)
+	assert.Equal(t, &models.TransportVlans{AVlan: 100}, spec.Transports[0].Vlans)
+	assert.Equal(t, "paris/backbone", spec.Attachments[0].Name())
+	assert.Equal(t, models.AttachmentSideA, spec.Attachments[0].Side)
+}
+
+func TestLoadJSON(t *testing.T) {
+	spec, err := Load(strings.NewReader(`{"workspaceId": "ws", "transports": [{"name": "backbone", "sku": "TRANSPORT"}]}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "backbone", spec.Transports[0].Name)
+}
+
+func TestLoadUnknownField(t *testing.T) {
+	_, err := Load(strings.NewReader("workspaceId: ws\nrouters: []\n"))
+
+	assert.ErrorIs(t, err, ErrInvalidSpec)
+}
+
+func TestValidate(t *testing.T) {
+	node := NodeSpec{Name: "paris", Type: models.NodeTypeAccess, SKU: "ACCESS"}
+	transport := TransportSpec{Name: "backbone", SKU: "TRANSPORT"}
+
+	tests := []struct {
+		name  string
+		spec  WorkspaceSpec
+		valid bool
+	}{
+		{
+			name: "valid spec",
+			spec: WorkspaceSpec{
+				WorkspaceID: "ws",
+				Nodes:       []NodeSpec{node},
+				Transports:  []TransportSpec{transport},
+				Attachments: []AttachmentSpec{{Node: "paris", Transport: "backbone", Side: models.AttachmentSideZ}},
+			},
+			valid: true,
+		},
+		{
+			name: "missing workspace id",
+			spec: WorkspaceSpec{},
+		},
+		{
+			name: "node declared twice",
+			spec: WorkspaceSpec{WorkspaceID: "ws", Nodes: []NodeSpec{node, node}},
+		},
+		{
+			name: "unknown node type",
+			spec: WorkspaceSpec{WorkspaceID: "ws", Nodes: []NodeSpec{{Name: "paris", Type: "switch", SKU: "ACCESS"}}},
+		},
+		{
+			name: "cloud node without provider config",
+			spec: WorkspaceSpec{WorkspaceID: "ws", Nodes: []NodeSpec{{Name: "aws", Type: models.NodeTypeCloud, SKU: "AWS"}}},
+		},
+		{
+			name: "invalid physical port id",
+			spec: WorkspaceSpec{WorkspaceID: "ws", Nodes: []NodeSpec{{Name: "paris", Type: models.NodeTypeAccess, SKU: "ACCESS", PhysicalPortID: "port"}}},
+		},
+		{
+			name: "transport without sku",
+			spec: WorkspaceSpec{WorkspaceID: "ws", Transports: []TransportSpec{{Name: "backbone"}}},
+		},
+		{
+			name: "vlan out of range",
+			spec: WorkspaceSpec{WorkspaceID: "ws", Transports: []TransportSpec{{Name: "backbone", SKU: "TRANSPORT", Vlans: &models.TransportVlans{AVlan: 4095}}}},
+		},
+		{
+			name: "attachment to an unknown node",
+			spec: WorkspaceSpec{
+				WorkspaceID: "ws",
+				Transports:  []TransportSpec{transport},
+				Attachments: []AttachmentSpec{{Node: "paris", Transport: "backbone"}},
+			},
+		},
+		{
+			name: "side used twice",
+			spec: WorkspaceSpec{
+				WorkspaceID: "ws",
+				Nodes:       []NodeSpec{node, {Name: "london", Type: models.NodeTypeAccess, SKU: "ACCESS"}},
+				Transports:  []TransportSpec{transport},
+				Attachments: []AttachmentSpec{
+					{Node: "paris", Transport: "backbone", Side: models.AttachmentSideA},
+					{Node: "london", Transport: "backbone", Side: models.AttachmentSideA},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Log(tc.name)
+		tc := tc
+		err := tc.spec.Validate()
+		if tc.valid {
+			assert.NoError(t, err)
+		} else {
+			assert.ErrorIs(t, err, ErrInvalidSpec)
+		}
+	}
+}
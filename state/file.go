@@ -0,0 +1,160 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var (
+	ErrLocked     = errors.New("state file is locked by another writer")
+	ErrNotLocked  = errors.New("state file lock has been released")
+	ErrStaleState = errors.New("state file has been written since it was read")
+)
+
+// File is a state file locked for writing. The lock is a sibling file created exclusively,
+// so that concurrent writers, possibly in other processes, are rejected.
+type File struct {
+	path     string
+	lockPath string
+	locked   bool
+}
+
+// Lock takes the write lock of the state file stored at path. It fails with ErrLocked if the
+// lock is already held. The lock must be released with Unlock.
+//
+// A writer which crashed or was killed leaves its lock behind, the ErrLocked error then names
+// the pid of the writer and the time it took the lock. Once sure this writer is gone, the lock
+// is released with ForceUnlock.
+func Lock(path string) (*File, error) {
+	lockPath := lockPathOf(path)
+	lock, err := os.OpenFile(lockPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			holder, _ := os.ReadFile(lockPath)
+			return nil, fmt.Errorf("%w: %s (%s), release it with ForceUnlock if this writer is gone", ErrLocked, lockPath, holder)
+		}
+		return nil, err
+	}
+	defer lock.Close()
+
+	if _, err := fmt.Fprintf(lock, "pid %d since %s", os.Getpid(), time.Now().UTC().Format(time.RFC3339)); err != nil {
+		os.Remove(lockPath)
+		return nil, err
+	}
+
+	return &File{path: path, lockPath: lockPath, locked: true}, nil
+}
+
+// ForceUnlock releases the write lock of the state file stored at path, whoever holds it.
+// It is meant to recover from a writer which crashed while holding the lock: releasing the
+// lock of a running writer lets another one overwrite its changes. It fails with ErrNotLocked
+// if the state file is not locked.
+func ForceUnlock(path string) error {
+	err := os.Remove(lockPathOf(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotLocked
+	}
+
+	return err
+}
+
+func lockPathOf(path string) string {
+	return path + ".lock"
+}
+
+// Unlock releases the write lock.
+func (f *File) Unlock() error {
+	if !f.locked {
+		return ErrNotLocked
+	}
+	f.locked = false
+
+	return os.Remove(f.lockPath)
+}
+
+// Read reads the locked state file, an empty state is returned if it does not exist yet.
+func (f *File) Read() (*State, error) {
+	if !f.locked {
+		return nil, ErrNotLocked
+	}
+
+	return Read(f.path)
+}
+
+// Write writes the state, whose serial must match the one of the state file, and increments
+// its serial. It fails with ErrStaleState if the file has been written since the state was read.
+// The file is replaced atomically.
+func (f *File) Write(s *State) error {
+	if !f.locked {
+		return ErrNotLocked
+	}
+
+	current, err := Read(f.path)
+	if err != nil {
+		return err
+	}
+	if current.Serial != s.Serial {
+		return fmt.Errorf("%w: serial is %d on disk, %d in memory", ErrStaleState, current.Serial, s.Serial)
+	}
+
+	next := *s
+	next.Version = Version
+	next.Serial++
+
+	data, err := json.MarshalIndent(next, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), f.path); err != nil {
+		return err
+	}
+
+	s.Version = next.Version
+	s.Serial = next.Serial
+
+	return nil
+}
+
+// Read reads the state file stored at path without locking it, an empty state is
+// returned if it does not exist yet.
+func Read(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return New(), nil
+		}
+		return nil, err
+	}
+
+	s := &State{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("cannot decode state file %s: %w", path, err)
+	}
+	if s.Version > Version {
+		return nil, fmt.Errorf("%w: %d, up to %d is supported", ErrUnsupportedVersion, s.Version, Version)
+	}
+	if s.Resources == nil {
+		s.Resources = []Resource{}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,104 @@
+package state
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+func TestFileReadWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "autonomi.state.json")
+
+	f, err := Lock(path)
+	assert.NoError(t, err)
+
+	s, err := f.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, New(), s)
+
+	s.Set(Resource{Kind: models.ElementKindNode, Name: "paris", ID: "node-id", WorkspaceID: "ws", SKU: "ACCESS", State: models.AdministrativeStateDeployed})
+	assert.NoError(t, f.Write(s))
+	assert.Equal(t, int64(1), s.Serial)
+
+	assert.NoError(t, f.Unlock())
+
+	read, err := Read(path)
+	assert.NoError(t, err)
+	assert.Equal(t, Version, read.Version)
+	assert.Equal(t, int64(1), read.Serial)
+	resource, found := read.Get(models.ElementKindNode, "paris")
+	assert.True(t, found)
+	assert.Equal(t, "node-id", resource.ID)
+}
+
+func TestFileConcurrentWriters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "autonomi.state.json")
+
+	f, err := Lock(path)
+	assert.NoError(t, err)
+
+	_, err = Lock(path)
+	assert.ErrorIs(t, err, ErrLocked)
+
+	assert.NoError(t, f.Unlock())
+	assert.ErrorIs(t, f.Unlock(), ErrNotLocked)
+
+	_, err = f.Read()
+	assert.ErrorIs(t, err, ErrNotLocked)
+	assert.ErrorIs(t, f.Write(New()), ErrNotLocked)
+
+	f, err = Lock(path)
+	assert.NoError(t, err)
+	defer f.Unlock()
+}
+
+func TestForceUnlock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "autonomi.state.json")
+	assert.ErrorIs(t, ForceUnlock(path), ErrNotLocked)
+
+	// a writer crashed without releasing its lock
+	_, err := Lock(path)
+	assert.NoError(t, err)
+
+	_, err = Lock(path)
+	assert.ErrorIs(t, err, ErrLocked)
+	assert.Contains(t, err.Error(), fmt.Sprintf("pid %d since", os.Getpid()))
+
+	assert.NoError(t, ForceUnlock(path))
+
+	f, err := Lock(path)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Unlock())
+}
+
+func TestFileStaleState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "autonomi.state.json")
+
+	stale, err := Read(path)
+	assert.NoError(t, err)
+
+	f, err := Lock(path)
+	assert.NoError(t, err)
+	defer f.Unlock()
+
+	s, err := f.Read()
+	assert.NoError(t, err)
+	assert.NoError(t, f.Write(s))
+
+	// the state read before the last write must not overwrite it
+	assert.ErrorIs(t, f.Write(stale), ErrStaleState)
+}
+
+func TestReadUnsupportedVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "autonomi.state.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"version": 2, "serial": 3, "resources": []}`), 0o600))
+
+	_, err := Read(path)
+
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
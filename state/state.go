@@ -0,0 +1,170 @@
+// Package state records in a local file the ids of the elements created through the SDK,
+// indexed by a logical name chosen by the caller.
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// Version is the version of the state format written by this package.
+const Version = 1
+
+var ErrUnsupportedVersion = errors.New("state file version is not supported")
+
+// Resource maps the logical name of an element to its id and keeps its last known state.
+type Resource struct {
+	Kind        models.ElementKind         `json:"kind"`
+	Name        string                     `json:"name"`
+	ID          string                     `json:"id"`
+	WorkspaceID string                     `json:"workspaceId"`
+	SKU         string                     `json:"sku,omitempty"`
+	State       models.AdministrativeState `json:"state"`
+	UpdatedAt   time.Time                  `json:"updatedAt"`
+}
+
+// State is the content of a state file.
+type State struct {
+	Version int `json:"version"`
+	// Serial is incremented at each write, it allows detecting concurrent writers.
+	Serial    int64      `json:"serial"`
+	Resources []Resource `json:"resources"`
+}
+
+// New returns an empty state.
+func New() *State {
+	return &State{
+		Version:   Version,
+		Resources: []Resource{},
+	}
+}
+
+// Get returns the resource of the given kind recorded under name.
+func (s *State) Get(kind models.ElementKind, name string) (*Resource, bool) {
+	for i := range s.Resources {
+		if s.Resources[i].Kind == kind && s.Resources[i].Name == name {
+			return &s.Resources[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// Set records the resource, replacing the one of the same kind and name if any.
+func (s *State) Set(resource Resource) {
+	if resource.UpdatedAt.IsZero() {
+		resource.UpdatedAt = time.Now().UTC()
+	}
+
+	if current, found := s.Get(resource.Kind, resource.Name); found {
+		*current = resource
+		return
+	}
+
+	s.Resources = append(s.Resources, resource)
+	slices.SortFunc(s.Resources, func(a, b Resource) int {
+		if a.Kind != b.Kind {
+			return strings.Compare(a.Kind.String(), b.Kind.String())
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// Remove forgets the resource of the given kind recorded under name and reports whether it was found.
+func (s *State) Remove(kind models.ElementKind, name string) bool {
+	for i := range s.Resources {
+		if s.Resources[i].Kind == kind && s.Resources[i].Name == name {
+			s.Resources = slices.Delete(s.Resources, i, i+1)
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetNode records a node under name.
+func (s *State) SetNode(name string, node *models.Node) {
+	s.Set(Resource{
+		Kind:        models.ElementKindNode,
+		Name:        name,
+		ID:          node.ID.String(),
+		WorkspaceID: node.WorkspaceID,
+		SKU:         node.Product.SKU,
+		State:       node.State,
+	})
+}
+
+// SetTransport records a transport under name.
+func (s *State) SetTransport(name string, transport *models.Transport) {
+	s.Set(Resource{
+		Kind:        models.ElementKindTransport,
+		Name:        name,
+		ID:          transport.ID.String(),
+		WorkspaceID: transport.WorkspaceID,
+		SKU:         transport.Product.SKU,
+		State:       transport.State,
+	})
+}
+
+// SetAttachment records an attachment under name.
+func (s *State) SetAttachment(name string, attachment *models.Attachment) {
+	s.Set(Resource{
+		Kind:        models.ElementKindAttachment,
+		Name:        name,
+		ID:          attachment.ID.String(),
+		WorkspaceID: attachment.WorkspaceID,
+		State:       attachment.State,
+	})
+}
+
+// Refresh fetches every recorded element and updates its SKU and state. The elements which
+// do not exist anymore are removed from the state. The elements which cannot be fetched are
+// kept untouched and their errors are returned joined.
+func (s *State) Refresh(ctx context.Context, client *autonomisdk.Client) error {
+	errs := []error{}
+	for _, resource := range slices.Clone(s.Resources) {
+		var err error
+		switch resource.Kind {
+		case models.ElementKindNode:
+			var node *models.Node
+			if node, err = client.GetNode(ctx, resource.WorkspaceID, resource.ID); err == nil {
+				resource.SKU = node.Product.SKU
+				resource.State = node.State
+			}
+		case models.ElementKindTransport:
+			var transport *models.Transport
+			if transport, err = client.GetTransport(ctx, resource.WorkspaceID, resource.ID); err == nil {
+				resource.SKU = transport.Product.SKU
+				resource.State = transport.State
+			}
+		case models.ElementKindAttachment:
+			var attachment *models.Attachment
+			if attachment, err = client.GetAttachment(ctx, resource.WorkspaceID, resource.ID); err == nil {
+				resource.State = attachment.State
+			}
+		default:
+			err = fmt.Errorf("unknown kind '%s'", resource.Kind)
+		}
+
+		if err != nil {
+			if strings.Contains(err.Error(), "status: 404") {
+				s.Remove(resource.Kind, resource.Name)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("cannot refresh %s '%s': %w", resource.Kind, resource.Name, err))
+			continue
+		}
+
+		resource.UpdatedAt = time.Now().UTC()
+		s.Set(resource)
+	}
+
+	return errors.Join(errs...)
+}
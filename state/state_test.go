@@ -0,0 +1,121 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/stretchr/testify/assert"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+const (
+	accountID   = "e1b4ff0f-8ab1-4b2e-a0b4-2fb8e6c0b1c2"
+	workspaceID = "84d2e5a6-3f14-4b9a-9d5c-1e6f0a2b7c3d"
+)
+
+var (
+	nodeID       = uuid.MustParse("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d")
+	transportID  = uuid.MustParse("3d4e5f6a-7b8c-4d9e-9f0a-2b3c4d5e6f7a")
+	attachmentID = uuid.MustParse("5f6a7b8c-9d0e-4f1a-9b2c-4d5e6f7a8b9c")
+)
+
+func TestStateSetGetRemove(t *testing.T) {
+	s := New()
+
+	s.SetTransport("backbone", &models.Transport{
+		BaseModel:   models.BaseModel{ID: transportID},
+		WorkspaceID: workspaceID,
+		State:       models.AdministrativeStateCreationPending,
+		Product:     models.TransportProduct{Product: models.Product{SKU: "TRANSPORT-1G"}},
+	})
+	s.SetNode("paris", &models.Node{
+		BaseModel:   models.BaseModel{ID: nodeID},
+		WorkspaceID: workspaceID,
+		State:       models.AdministrativeStateDeployed,
+		Product:     models.NodeProduct{Product: models.Product{SKU: "ACCESS-PAR-1G"}},
+	})
+
+	resource, found := s.Get(models.ElementKindNode, "paris")
+	assert.True(t, found)
+	assert.Equal(t, nodeID.String(), resource.ID)
+	assert.Equal(t, "ACCESS-PAR-1G", resource.SKU)
+	assert.False(t, resource.UpdatedAt.IsZero())
+
+	// resources are sorted by kind then name
+	assert.Equal(t, models.ElementKindNode, s.Resources[0].Kind)
+
+	s.SetNode("paris", &models.Node{BaseModel: models.BaseModel{ID: nodeID}, State: models.AdministrativeStateUpdatePending})
+	assert.Len(t, s.Resources, 2)
+
+	_, found = s.Get(models.ElementKindTransport, "paris")
+	assert.False(t, found)
+
+	assert.True(t, s.Remove(models.ElementKindNode, "paris"))
+	assert.False(t, s.Remove(models.ElementKindNode, "paris"))
+	assert.Len(t, s.Resources, 1)
+}
+
+func TestStateRefresh(t *testing.T) {
+	g := NewWithT(t)
+	gh := ghttp.NewGHTTPWithGomega(g)
+	server := ghttp.NewServer()
+	defer server.Close()
+
+	server.AppendHandlers(
+		gh.RespondWithJSONEncoded(http.StatusOK, models.Self{AccountID: uuid.MustParse(accountID)}),
+	)
+
+	hostURL, err := url.Parse(server.URL())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	client, err := autonomisdk.NewClient(true, autonomisdk.WithHostURL(hostURL), autonomisdk.WithPersonalAccessToken("token"))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	s := New()
+	s.Set(Resource{Kind: models.ElementKindAttachment, Name: "paris/backbone", ID: attachmentID.String(), WorkspaceID: workspaceID, State: models.AdministrativeStateDeployed})
+	s.Set(Resource{Kind: models.ElementKindNode, Name: "paris", ID: nodeID.String(), WorkspaceID: workspaceID, SKU: "ACCESS-PAR-1G", State: models.AdministrativeStateCreationPending})
+	s.Set(Resource{Kind: models.ElementKindTransport, Name: "backbone", ID: transportID.String(), WorkspaceID: workspaceID, SKU: "TRANSPORT-1G", State: models.AdministrativeStateDeployed})
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/attachments/%s", accountID, workspaceID, attachmentID)),
+			gh.RespondWithJSONEncoded(http.StatusNotFound, nil),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes/%s", accountID, workspaceID, nodeID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.NodeResponse{Data: models.Node{
+				BaseModel: models.BaseModel{ID: nodeID},
+				State:     models.AdministrativeStateDeployed,
+				Product:   models.NodeProduct{Product: models.Product{SKU: "ACCESS-PAR-10G"}},
+			}}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/transports/%s", accountID, workspaceID, transportID)),
+			gh.RespondWithJSONEncoded(http.StatusInternalServerError, nil),
+		),
+	)
+
+	err = s.Refresh(context.Background(), client)
+
+	g.Expect(err).Should(MatchError(ContainSubstring("cannot refresh transport 'backbone': status: 500")))
+	g.Expect(s.Resources).Should(HaveLen(2))
+
+	_, found := s.Get(models.ElementKindAttachment, "paris/backbone")
+	g.Expect(found).Should(BeFalse())
+
+	node, found := s.Get(models.ElementKindNode, "paris")
+	g.Expect(found).Should(BeTrue())
+	g.Expect(node.SKU).Should(Equal("ACCESS-PAR-10G"))
+	g.Expect(node.State).Should(Equal(models.AdministrativeStateDeployed))
+
+	transport, found := s.Get(models.ElementKindTransport, "backbone")
+	g.Expect(found).Should(BeTrue())
+	g.Expect(transport.State).Should(Equal(models.AdministrativeStateDeployed))
+}
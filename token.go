@@ -0,0 +1,121 @@
+package autonomisdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+var ErrTokenExpirationInPast = errors.New("token expiration date must be in the future")
+
+// ListPersonalAccessTokens lists all the personal access tokens of the current user, fetching every page.
+func (c *Client) ListPersonalAccessTokens(ctx context.Context, options ...ListOption) ([]models.PersonalAccessToken, error) {
+	return Collect(c.AllPersonalAccessTokens(ctx, options...), 0)
+}
+
+// AllPersonalAccessTokens returns an iterator over the personal access tokens of the current user
+// which lazily fetches the pages.
+func (c *Client) AllPersonalAccessTokens(ctx context.Context, options ...ListOption) iter.Seq2[models.PersonalAccessToken, error] {
+	return paginate(ctx, c, fmt.Sprintf("%s/users/self/tokens", c.hostURL), options, func(resp []byte) ([]models.PersonalAccessToken, *models.Pagination, error) {
+		tokens := models.PersonalAccessTokensResponse{}
+		if err := json.Unmarshal(resp, &tokens); err != nil {
+			return nil, nil, err
+		}
+
+		return tokens.Data, tokens.Meta, nil
+	})
+}
+
+// CreatePersonalAccessToken creates a personal access token for the current user. The secret value of
+// the token is only returned by this call, in the field Token.
+func (c *Client) CreatePersonalAccessToken(ctx context.Context, payload models.CreatePersonalAccessToken) (*models.PersonalAccessToken, error) {
+	body := new(bytes.Buffer)
+	err := json.NewEncoder(body).Encode(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if errV := c.validate.StructCtx(ctx, payload); errV != nil {
+		return nil, errV
+	}
+
+	if payload.ExpiresAt != nil && !payload.ExpiresAt.After(time.Now()) {
+		return nil, ErrTokenExpirationInPast
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/users/self/tokens", c.hostURL), body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	token := models.PersonalAccessTokenResponse{}
+	if err = json.Unmarshal(resp, &token); err != nil {
+		return nil, err
+	}
+
+	return &token.Data, nil
+}
+
+// RevokePersonalAccessToken revokes a personal access token of the current user.
+func (c *Client) RevokePersonalAccessToken(ctx context.Context, tokenID string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/users/self/tokens/%s", c.hostURL, tokenID), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.doRequest(req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetCurrentPersonalAccessToken retrieves the personal access token used by the client.
+func (c *Client) GetCurrentPersonalAccessToken(ctx context.Context) (*models.PersonalAccessToken, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/users/self/tokens/current", c.hostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	token := models.PersonalAccessTokenResponse{}
+	if err = json.Unmarshal(resp, &token); err != nil {
+		return nil, err
+	}
+
+	return &token.Data, nil
+}
+
+// CheckPersonalAccessTokenExpiry reports whether the personal access token used by the client expires
+// within the given window, in which case a warning is logged.
+func (c *Client) CheckPersonalAccessTokenExpiry(ctx context.Context, window time.Duration) (*models.PersonalAccessToken, bool, error) {
+	token, err := c.GetCurrentPersonalAccessToken(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !token.ExpiresBefore(time.Now().Add(window)) {
+		return token, false, nil
+	}
+
+	log.Printf("personal access token '%s' expires on %s, please renew it", token.Name, token.ExpiresAt.Format(time.RFC3339))
+
+	return token, true, nil
+}
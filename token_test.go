@@ -0,0 +1,159 @@
+package autonomisdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intercloud/autonomi-sdk/models"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var (
+	tokenID = uuid.MustParse("8d4b8f9a-1e3c-4d1b-8c6f-0a5b4e3d2f1a")
+)
+
+func newPersonalAccessToken(expiresAt *time.Time) models.PersonalAccessToken {
+	return models.PersonalAccessToken{
+		BaseModel: models.BaseModel{
+			ID: tokenID,
+		},
+		Name:      "ci-pipeline",
+		Scopes:    []models.TokenScope{models.TokenScopeRead, models.TokenScopeWrite},
+		ExpiresAt: expiresAt,
+	}
+}
+
+func TestListPersonalAccessTokensSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	tokens := []models.PersonalAccessToken{newPersonalAccessToken(nil)}
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, "/users/self/tokens"),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, models.PersonalAccessTokensResponse{Data: tokens}),
+		),
+	)
+
+	data, err := cli.ListPersonalAccessTokens(context.Background())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal(tokens))
+}
+
+func TestCreatePersonalAccessTokenSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	expiresAt := time.Now().Add(90 * 24 * time.Hour).UTC().Truncate(time.Second)
+	result := newPersonalAccessToken(&expiresAt)
+	result.Token = "secret-token-value"
+
+	payload := models.CreatePersonalAccessToken{
+		Name:      "ci-pipeline",
+		Scopes:    []models.TokenScope{models.TokenScopeRead, models.TokenScopeWrite},
+		ExpiresAt: &expiresAt,
+	}
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPost, "/users/self/tokens"),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.VerifyJSONRepresenting(payload),
+			gh.RespondWithJSONEncoded(http.StatusCreated, models.PersonalAccessTokenResponse{Data: result}),
+		),
+	)
+
+	data, err := cli.CreatePersonalAccessToken(context.Background(), payload)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(result))
+}
+
+func TestCreatePersonalAccessTokenFailedValidator(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.CreatePersonalAccessToken(
+		context.Background(),
+		models.CreatePersonalAccessToken{
+			Name:   "ci-pipeline",
+			Scopes: []models.TokenScope{"admin"},
+		},
+	)
+
+	g.Expect(err.Error()).Should(Equal("Key: 'CreatePersonalAccessToken.Scopes[0]' Error:Field validation for 'Scopes[0]' failed on the 'oneof' tag"))
+	g.Expect(data).Should(BeNil())
+
+	expired := time.Now().Add(-time.Hour)
+	data, err = cli.CreatePersonalAccessToken(
+		context.Background(),
+		models.CreatePersonalAccessToken{
+			Name:      "ci-pipeline",
+			Scopes:    []models.TokenScope{models.TokenScopeRead},
+			ExpiresAt: &expired,
+		},
+	)
+
+	g.Expect(err).Should(Equal(ErrTokenExpirationInPast))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestRevokePersonalAccessTokenSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodDelete, fmt.Sprintf("/users/self/tokens/%s", tokenID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusNoContent, nil),
+		),
+	)
+
+	err := cli.RevokePersonalAccessToken(context.Background(), tokenID.String())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestCheckPersonalAccessTokenExpiry(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	expiresSoon := time.Now().Add(3 * 24 * time.Hour).UTC().Truncate(time.Second)
+	expiresLater := time.Now().Add(60 * 24 * time.Hour).UTC().Truncate(time.Second)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, "/users/self/tokens/current"),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.PersonalAccessTokenResponse{Data: newPersonalAccessToken(&expiresSoon)}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, "/users/self/tokens/current"),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.PersonalAccessTokenResponse{Data: newPersonalAccessToken(&expiresLater)}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, "/users/self/tokens/current"),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.PersonalAccessTokenResponse{Data: newPersonalAccessToken(nil)}),
+		),
+	)
+
+	token, expiring, err := cli.CheckPersonalAccessTokenExpiry(context.Background(), 7*24*time.Hour)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(expiring).Should(BeTrue())
+	g.Expect(*token.ExpiresAt).Should(Equal(expiresSoon))
+
+	_, expiring, err = cli.CheckPersonalAccessTokenExpiry(context.Background(), 7*24*time.Hour)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(expiring).Should(BeFalse())
+
+	_, expiring, err = cli.CheckPersonalAccessTokenExpiry(context.Background(), 7*24*time.Hour)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(expiring).Should(BeFalse())
+}
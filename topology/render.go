@@ -0,0 +1,219 @@
+package topology
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// stateClass groups the administrative states which are drawn with the same colour.
+type stateClass string
+
+const (
+	stateClassDeployed   stateClass = "deployed"
+	stateClassInProgress stateClass = "in_progress"
+	stateClassError      stateClass = "error"
+	stateClassDeleting   stateClass = "deleting"
+	stateClassUnknown    stateClass = "unknown"
+)
+
+var stateClassColors = map[stateClass]string{
+	stateClassDeployed:   "#2e7d32",
+	stateClassInProgress: "#ef6c00",
+	stateClassError:      "#c62828",
+	stateClassDeleting:   "#757575",
+	stateClassUnknown:    "#1565c0",
+}
+
+// stateClasses lists the classes in the order they are declared in the diagrams.
+var stateClasses = []stateClass{stateClassDeployed, stateClassInProgress, stateClassError, stateClassDeleting, stateClassUnknown}
+
+func classOf(state models.AdministrativeState) stateClass {
+	switch state {
+	case models.AdministrativeStateDeployed:
+		return stateClassDeployed
+	case models.AdministrativeStateCreationPending, models.AdministrativeStateCreationProceed, models.AdministrativeStateCreated,
+		models.AdministrativeStateUpdatePending, models.AdministrativeStateUpdateProceed:
+		return stateClassInProgress
+	case models.AdministrativeStateCreationError, models.AdministrativeStateUpdateError, models.AdministrativeStateDeleteError:
+		return stateClassError
+	case models.AdministrativeStateDeletePending, models.AdministrativeStateDeleteProceed, models.AdministrativeStateDeleted:
+		return stateClassDeleting
+	}
+
+	return stateClassUnknown
+}
+
+// nodeLabel returns the lines describing a node: its name, type, provider, location and cloud service provider.
+func nodeLabel(node models.Node) []string {
+	lines := []string{node.Name}
+
+	details := []string{}
+	if node.Type != "" {
+		details = append(details, node.Type.String())
+	}
+	if node.Product.Provider != "" {
+		details = append(details, node.Product.Provider.String())
+	}
+	if node.Product.Location != "" {
+		details = append(details, node.Product.Location)
+	}
+	if len(details) > 0 {
+		lines = append(lines, strings.Join(details, " - "))
+	}
+
+	if node.Product.CSPName != "" {
+		csp := node.Product.CSPName
+		if node.Product.CSPRegion != "" {
+			csp += " " + node.Product.CSPRegion
+		}
+		lines = append(lines, csp)
+	}
+
+	return lines
+}
+
+// transportLabel returns the lines describing a transport: its name, bandwidth and VLANs.
+func transportLabel(transport models.Transport) []string {
+	lines := []string{transport.Name}
+	if transport.Product.Bandwidth > 0 {
+		lines = append(lines, fmt.Sprintf("bandwidth %d", transport.Product.Bandwidth))
+	}
+
+	vlans := []string{}
+	if transport.TransportVlans.AVlan != 0 {
+		vlans = append(vlans, fmt.Sprintf("A %d", transport.TransportVlans.AVlan))
+	}
+	if transport.TransportVlans.ZVlan != 0 {
+		vlans = append(vlans, fmt.Sprintf("Z %d", transport.TransportVlans.ZVlan))
+	}
+	if len(vlans) > 0 {
+		lines = append(lines, "vlan "+strings.Join(vlans, ", "))
+	}
+
+	return lines
+}
+
+var dotShapes = map[models.NodeType]string{
+	models.NodeTypeAccess: "box",
+	models.NodeTypeCloud:  "ellipse",
+	models.NodeTypeBridge: "diamond",
+	models.NodeTypeRouter: "hexagon",
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
+func dotLabel(lines []string) string {
+	escaped := make([]string, 0, len(lines))
+	for _, line := range lines {
+		escaped = append(escaped, dotEscape(line))
+	}
+
+	return strings.Join(escaped, `\n`)
+}
+
+// DOT renders the topology as a Graphviz undirected graph. Nodes are drawn with a shape depending
+// on their type, transports as small rounded boxes linked to the nodes by the attachments, which are
+// labelled with their side. Every element is coloured according to its administrative state.
+func (g *Graph) DOT() string {
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "graph \"%s\" {\n", dotEscape(g.WorkspaceID))
+	b.WriteString("  node [fontname=\"Helvetica\"];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\"];\n")
+
+	for _, vertex := range g.SortedVertices() {
+		node := vertex.Node
+		shape, found := dotShapes[node.Type]
+		if !found {
+			shape = "box"
+		}
+		fmt.Fprintf(b, "  \"%s\" [label=\"%s\", shape=%s, color=\"%s\"];\n",
+			node.ID, dotLabel(nodeLabel(node)), shape, stateClassColors[classOf(node.State)])
+	}
+
+	for _, edge := range g.SortedEdges() {
+		transport := edge.Transport
+		fmt.Fprintf(b, "  \"%s\" [label=\"%s\", shape=box, style=rounded, fontsize=10, color=\"%s\"];\n",
+			transport.ID, dotLabel(transportLabel(transport)), stateClassColors[classOf(transport.State)])
+		for _, attachment := range edge.Attachments {
+			fmt.Fprintf(b, "  \"%s\" -- \"%s\" [label=\"%s\", color=\"%s\"];\n",
+				attachment.NodeID, attachment.TransportID, dotEscape(attachment.Side.String()), stateClassColors[classOf(attachment.State)])
+		}
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func mermaidID(prefix, id string) string {
+	return prefix + strings.ReplaceAll(id, "-", "")
+}
+
+func mermaidLabel(lines []string) string {
+	escaped := make([]string, 0, len(lines))
+	for _, line := range lines {
+		escaped = append(escaped, strings.ReplaceAll(line, `"`, "#quot;"))
+	}
+
+	return `"` + strings.Join(escaped, "<br/>") + `"`
+}
+
+// mermaidShapes maps a node type to the opening and closing delimiters of its Mermaid shape.
+var mermaidShapes = map[models.NodeType][2]string{
+	models.NodeTypeAccess: {"[", "]"},
+	models.NodeTypeCloud:  {"([", "])"},
+	models.NodeTypeBridge: {"{", "}"},
+	models.NodeTypeRouter: {"{{", "}}"},
+}
+
+// Mermaid renders the topology as a Mermaid flowchart, with the same conventions as DOT.
+func (g *Graph) Mermaid() string {
+	b := new(strings.Builder)
+	b.WriteString("flowchart LR\n")
+
+	classes := map[stateClass][]string{}
+	for _, vertex := range g.SortedVertices() {
+		node := vertex.Node
+		id := mermaidID("n", node.ID.String())
+		shape, found := mermaidShapes[node.Type]
+		if !found {
+			shape = mermaidShapes[models.NodeTypeAccess]
+		}
+		fmt.Fprintf(b, "  %s%s%s%s\n", id, shape[0], mermaidLabel(nodeLabel(node)), shape[1])
+		classes[classOf(node.State)] = append(classes[classOf(node.State)], id)
+	}
+
+	links := []string{}
+	for _, edge := range g.SortedEdges() {
+		transport := edge.Transport
+		id := mermaidID("t", transport.ID.String())
+		fmt.Fprintf(b, "  %s(%s)\n", id, mermaidLabel(transportLabel(transport)))
+		classes[classOf(transport.State)] = append(classes[classOf(transport.State)], id)
+		for _, attachment := range edge.Attachments {
+			nodeID := mermaidID("n", attachment.NodeID)
+			if attachment.Side != "" {
+				fmt.Fprintf(b, "  %s ---|%s| %s\n", nodeID, attachment.Side, id)
+			} else {
+				fmt.Fprintf(b, "  %s --- %s\n", nodeID, id)
+			}
+			links = append(links, stateClassColors[classOf(attachment.State)])
+		}
+	}
+
+	for i, color := range links {
+		fmt.Fprintf(b, "  linkStyle %d stroke:%s\n", i, color)
+	}
+	for _, class := range stateClasses {
+		if len(classes[class]) == 0 {
+			continue
+		}
+		fmt.Fprintf(b, "  classDef %s stroke:%s,stroke-width:2px\n", class, stateClassColors[class])
+		fmt.Fprintf(b, "  class %s %s\n", strings.Join(classes[class], ","), class)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,82 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+var workspaceID = uuid.MustParse("84d2e5a6-3f14-4b9a-9d5c-1e6f0a2b7c3d")
+
+// renderInventory returns a workspace where paris is linked to aws by a transport being created.
+func renderInventory() *autonomisdk.WorkspaceInventory {
+	paris := node(parisID, `paris "dc2"`, models.NodeTypeAccess)
+	paris.Product = models.NodeProduct{Product: models.Product{Provider: models.ProviderTypeEquinix, Location: "Paris"}}
+	aws := node(awsID, "aws", models.NodeTypeCloud)
+	aws.State = models.AdministrativeStateCreationError
+	aws.Product = models.NodeProduct{
+		Product:   models.Product{Provider: models.ProviderTypeMegaport, Location: "Frankfurt"},
+		CSPName:   "AWS",
+		CSPRegion: "eu-central-1",
+	}
+	backbone := transport(backboneID, "backbone")
+	backbone.State = models.AdministrativeStateCreationProceed
+	backbone.Product = models.TransportProduct{Product: models.Product{Bandwidth: 1000}}
+	backbone.TransportVlans = models.TransportVlans{AVlan: 100, ZVlan: 200}
+
+	return &autonomisdk.WorkspaceInventory{
+		Workspace:  models.Workspace{BaseModel: models.BaseModel{ID: workspaceID}},
+		Nodes:      []models.Node{paris, aws},
+		Transports: []models.Transport{backbone},
+		Attachments: []models.Attachment{
+			attachment(parisID, backboneID, models.AttachmentSideA),
+			attachment(awsID, backboneID, ""),
+		},
+	}
+}
+
+func TestDOT(t *testing.T) {
+	dot := Build(renderInventory()).DOT()
+
+	assert.Equal(t, `graph "84d2e5a6-3f14-4b9a-9d5c-1e6f0a2b7c3d" {
+  node [fontname="Helvetica"];
+  edge [fontname="Helvetica"];
+  "2c3d4e5f-6a7b-4c8d-8e9f-1a2b3c4d5e6f" [label="aws\ncloud - MEGAPORT - Frankfurt\nAWS eu-central-1", shape=ellipse, color="#c62828"];
+  "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d" [label="paris \"dc2\"\naccess - EQUINIX - Paris", shape=box, color="#2e7d32"];
+  "3d4e5f6a-7b8c-4d9e-9f0a-2b3c4d5e6f7a" [label="backbone\nbandwidth 1000\nvlan A 100, Z 200", shape=box, style=rounded, fontsize=10, color="#ef6c00"];
+  "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d" -- "3d4e5f6a-7b8c-4d9e-9f0a-2b3c4d5e6f7a" [label="A", color="#2e7d32"];
+  "2c3d4e5f-6a7b-4c8d-8e9f-1a2b3c4d5e6f" -- "3d4e5f6a-7b8c-4d9e-9f0a-2b3c4d5e6f7a" [label="", color="#2e7d32"];
+}
+`, dot)
+}
+
+func TestMermaid(t *testing.T) {
+	mermaid := Build(renderInventory()).Mermaid()
+
+	assert.Equal(t, `flowchart LR
+  n2c3d4e5f6a7b4c8d8e9f1a2b3c4d5e6f(["aws<br/>cloud - MEGAPORT - Frankfurt<br/>AWS eu-central-1"])
+  n0a1b2c3d4e5f4a6b8c7d9e0f1a2b3c4d["paris #quot;dc2#quot;<br/>access - EQUINIX - Paris"]
+  t3d4e5f6a7b8c4d9e9f0a2b3c4d5e6f7a("backbone<br/>bandwidth 1000<br/>vlan A 100, Z 200")
+  n0a1b2c3d4e5f4a6b8c7d9e0f1a2b3c4d ---|A| t3d4e5f6a7b8c4d9e9f0a2b3c4d5e6f7a
+  n2c3d4e5f6a7b4c8d8e9f1a2b3c4d5e6f --- t3d4e5f6a7b8c4d9e9f0a2b3c4d5e6f7a
+  linkStyle 0 stroke:#2e7d32
+  linkStyle 1 stroke:#2e7d32
+  classDef deployed stroke:#2e7d32,stroke-width:2px
+  class n0a1b2c3d4e5f4a6b8c7d9e0f1a2b3c4d deployed
+  classDef in_progress stroke:#ef6c00,stroke-width:2px
+  class t3d4e5f6a7b8c4d9e9f0a2b3c4d5e6f7a in_progress
+  classDef error stroke:#c62828,stroke-width:2px
+  class n2c3d4e5f6a7b4c8d8e9f1a2b3c4d5e6f error
+`, mermaid)
+}
+
+func TestRenderEmptyGraph(t *testing.T) {
+	g := Build(&autonomisdk.WorkspaceInventory{Workspace: models.Workspace{BaseModel: models.BaseModel{ID: workspaceID}}})
+
+	assert.Equal(t, "flowchart LR\n", g.Mermaid())
+	assert.Contains(t, g.DOT(), `graph "84d2e5a6-3f14-4b9a-9d5c-1e6f0a2b7c3d" {`)
+}
@@ -0,0 +1,180 @@
+// Package topology models a workspace as a graph whose vertices are the nodes and whose
+// edges are the transports, linked to the nodes by the attachments.
+package topology
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// Vertex is a node of the workspace with the transports it is attached to.
+type Vertex struct {
+	Node  models.Node
+	Edges []*Edge
+}
+
+// Edge is a transport of the workspace with the nodes attached to it.
+type Edge struct {
+	Transport   models.Transport
+	Attachments []models.Attachment
+	Vertices    []*Vertex
+}
+
+// Graph is the topology of a workspace. Elements being deleted are not part of it.
+type Graph struct {
+	WorkspaceID string
+	Vertices    map[string]*Vertex
+	Edges       map[string]*Edge
+	// Dangling lists the attachments whose node or transport is unknown.
+	Dangling []models.Attachment
+}
+
+// Build builds the graph of the elements of a workspace inventory.
+func Build(inventory *autonomisdk.WorkspaceInventory) *Graph {
+	g := &Graph{
+		WorkspaceID: inventory.Workspace.ID.String(),
+		Vertices:    map[string]*Vertex{},
+		Edges:       map[string]*Edge{},
+	}
+
+	for _, node := range inventory.Nodes {
+		if !node.State.IsDeleting() {
+			g.Vertices[node.ID.String()] = &Vertex{Node: node}
+		}
+	}
+	for _, transport := range inventory.Transports {
+		if !transport.State.IsDeleting() {
+			g.Edges[transport.ID.String()] = &Edge{Transport: transport}
+		}
+	}
+	for _, attachment := range inventory.Attachments {
+		if attachment.State.IsDeleting() {
+			continue
+		}
+		vertex, vertexFound := g.Vertices[attachment.NodeID]
+		edge, edgeFound := g.Edges[attachment.TransportID]
+		if !vertexFound || !edgeFound {
+			g.Dangling = append(g.Dangling, attachment)
+			continue
+		}
+		edge.Attachments = append(edge.Attachments, attachment)
+		edge.Vertices = append(edge.Vertices, vertex)
+		vertex.Edges = append(vertex.Edges, edge)
+	}
+
+	return g
+}
+
+// BuildFromWorkspace fetches the inventory of a workspace and builds its graph.
+// It fails if any kind of element cannot be listed, as the graph would be incomplete.
+func BuildFromWorkspace(ctx context.Context, client *autonomisdk.Client, workspaceID string) (*Graph, error) {
+	inventory, err := client.GetWorkspaceInventory(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := inventory.Err(); err != nil {
+		return nil, err
+	}
+
+	return Build(inventory), nil
+}
+
+// SortedVertices returns the vertices sorted by node name then id.
+func (g *Graph) SortedVertices() []*Vertex {
+	vertices := make([]*Vertex, 0, len(g.Vertices))
+	for _, vertex := range g.Vertices {
+		vertices = append(vertices, vertex)
+	}
+	slices.SortFunc(vertices, func(a, b *Vertex) int {
+		if c := strings.Compare(a.Node.Name, b.Node.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Node.ID.String(), b.Node.ID.String())
+	})
+
+	return vertices
+}
+
+// SortedEdges returns the edges sorted by transport name then id.
+func (g *Graph) SortedEdges() []*Edge {
+	edges := make([]*Edge, 0, len(g.Edges))
+	for _, edge := range g.Edges {
+		edges = append(edges, edge)
+	}
+	slices.SortFunc(edges, func(a, b *Edge) int {
+		if c := strings.Compare(a.Transport.Name, b.Transport.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Transport.ID.String(), b.Transport.ID.String())
+	})
+
+	return edges
+}
+
+// Path is a route between two nodes: Transports[i] links Nodes[i] to Nodes[i+1].
+type Path struct {
+	Nodes      []models.Node
+	Transports []models.Transport
+}
+
+// Path returns the shortest path between the nodes fromID and toID, and whether one exists.
+func (g *Graph) Path(fromID, toID string) (*Path, bool) {
+	from, fromFound := g.Vertices[fromID]
+	if !fromFound {
+		return nil, false
+	}
+	if _, toFound := g.Vertices[toID]; !toFound {
+		return nil, false
+	}
+
+	type hop struct {
+		previous *Vertex
+		edge     *Edge
+	}
+
+	// breadth first search, visited records how each vertex was reached
+	visited := map[string]hop{fromID: {}}
+	queue := []*Vertex{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current.Node.ID.String() == toID {
+			break
+		}
+		for _, edge := range current.Edges {
+			for _, next := range edge.Vertices {
+				if _, seen := visited[next.Node.ID.String()]; seen {
+					continue
+				}
+				visited[next.Node.ID.String()] = hop{previous: current, edge: edge}
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	if _, reached := visited[toID]; !reached {
+		return nil, false
+	}
+
+	path := &Path{}
+	for vertex := g.Vertices[toID]; vertex != nil; {
+		path.Nodes = append([]models.Node{vertex.Node}, path.Nodes...)
+		h := visited[vertex.Node.ID.String()]
+		if h.edge != nil {
+			path.Transports = append([]models.Transport{h.edge.Transport}, path.Transports...)
+		}
+		vertex = h.previous
+	}
+
+	return path, true
+}
+
+// Connected reports whether a path exists between the nodes aID and bID.
+func (g *Graph) Connected(aID, bID string) bool {
+	_, found := g.Path(aID, bID)
+	return found
+}
@@ -0,0 +1,183 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	autonomisdk "github.com/intercloud/autonomi-sdk"
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+var (
+	parisID     = uuid.MustParse("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d")
+	londonID    = uuid.MustParse("1b2c3d4e-5f6a-4b7c-9d8e-0f1a2b3c4d5e")
+	awsID       = uuid.MustParse("2c3d4e5f-6a7b-4c8d-8e9f-1a2b3c4d5e6f")
+	backboneID  = uuid.MustParse("3d4e5f6a-7b8c-4d9e-9f0a-2b3c4d5e6f7a")
+	cloudLinkID = uuid.MustParse("4e5f6a7b-8c9d-4e0f-8a1b-3c4d5e6f7a8b")
+)
+
+func node(id uuid.UUID, name string, nodeType models.NodeType) models.Node {
+	return models.Node{
+		BaseModel: models.BaseModel{ID: id},
+		Name:      name,
+		Type:      nodeType,
+		State:     models.AdministrativeStateDeployed,
+	}
+}
+
+func transport(id uuid.UUID, name string) models.Transport {
+	return models.Transport{
+		BaseModel: models.BaseModel{ID: id},
+		Name:      name,
+		State:     models.AdministrativeStateDeployed,
+	}
+}
+
+func attachment(nodeID, transportID uuid.UUID, side models.AttachmentSide) models.Attachment {
+	return models.Attachment{
+		BaseModel:   models.BaseModel{ID: uuid.New()},
+		NodeID:      nodeID.String(),
+		TransportID: transportID.String(),
+		Side:        side,
+		State:       models.AdministrativeStateDeployed,
+	}
+}
+
+// inventory returns a workspace where paris reaches aws through london:
+// paris -- backbone -- london -- cloud-link -- aws
+func inventory() *autonomisdk.WorkspaceInventory {
+	return &autonomisdk.WorkspaceInventory{
+		Nodes: []models.Node{
+			node(parisID, "paris", models.NodeTypeAccess),
+			node(londonID, "london", models.NodeTypeAccess),
+			node(awsID, "aws", models.NodeTypeCloud),
+		},
+		Transports: []models.Transport{
+			transport(backboneID, "backbone"),
+			transport(cloudLinkID, "cloud-link"),
+		},
+		Attachments: []models.Attachment{
+			attachment(parisID, backboneID, models.AttachmentSideA),
+			attachment(londonID, backboneID, models.AttachmentSideZ),
+			attachment(londonID, cloudLinkID, models.AttachmentSideA),
+			attachment(awsID, cloudLinkID, models.AttachmentSideZ),
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	g := Build(inventory())
+
+	assert.Len(t, g.Vertices, 3)
+	assert.Len(t, g.Edges, 2)
+	assert.Len(t, g.Vertices[londonID.String()].Edges, 2)
+	assert.Len(t, g.Edges[backboneID.String()].Vertices, 2)
+	assert.Empty(t, g.Dangling)
+	assert.Equal(t, "aws", g.SortedVertices()[0].Node.Name)
+	assert.Equal(t, "backbone", g.SortedEdges()[0].Transport.Name)
+}
+
+func TestPath(t *testing.T) {
+	g := Build(inventory())
+
+	path, found := g.Path(parisID.String(), awsID.String())
+
+	assert.True(t, found)
+	names := []string{}
+	for _, n := range path.Nodes {
+		names = append(names, n.Name)
+	}
+	assert.Equal(t, []string{"paris", "london", "aws"}, names)
+	assert.Equal(t, "backbone", path.Transports[0].Name)
+	assert.Equal(t, "cloud-link", path.Transports[1].Name)
+
+	path, found = g.Path(parisID.String(), parisID.String())
+	assert.True(t, found)
+	assert.Len(t, path.Nodes, 1)
+	assert.Empty(t, path.Transports)
+
+	_, found = g.Path(parisID.String(), uuid.NewString())
+	assert.False(t, found)
+}
+
+func TestValidateValidTopology(t *testing.T) {
+	g := Build(inventory())
+
+	assert.Empty(t, g.Validate())
+}
+
+func TestValidate(t *testing.T) {
+	orphanID := uuid.MustParse("5f6a7b8c-9d0e-4f1a-9b2c-4d5e6f7a8b9c")
+	unknownID := uuid.MustParse("6a7b8c9d-0e1f-4a2b-8c3d-5e6f7a8b9c0d")
+
+	inv := inventory()
+	inv.Nodes = append(inv.Nodes, node(orphanID, "orphan", models.NodeTypeAccess))
+	// the cloud link is removed: paris and london cannot reach aws anymore
+	inv.Transports = []models.Transport{transport(backboneID, "backbone"), transport(cloudLinkID, "cloud-link")}
+	inv.Transports[1].State = models.AdministrativeStateDeleteProceed
+	inv.Attachments = []models.Attachment{
+		attachment(parisID, backboneID, models.AttachmentSideA),
+		attachment(londonID, backboneID, models.AttachmentSideA),
+		attachment(awsID, unknownID, models.AttachmentSideZ),
+	}
+
+	issues := Build(inv).Validate()
+
+	kinds := []IssueKind{}
+	names := []string{}
+	for _, issue := range issues {
+		kinds = append(kinds, issue.Kind)
+		names = append(names, issue.Name)
+	}
+	assert.Equal(t, []IssueKind{
+		IssueSideConflict,
+		IssueOrphanNode,
+		IssueUnreachableCloud,
+		IssueOrphanNode,
+		IssueUnreachableCloud,
+		IssueUnreachableCloud,
+		IssueDanglingAttachment,
+	}, kinds)
+	assert.Equal(t, []string{"backbone", "aws", "london", "orphan", "orphan", "paris", ""}, names)
+	assert.Equal(t, "side_conflict: transport 'backbone' has 2 attachments on side A", issues[0].String())
+}
+
+func TestValidateUnterminatedTransport(t *testing.T) {
+	inv := inventory()
+	inv.Attachments = inv.Attachments[:3]
+
+	issues := Build(inv).Validate()
+
+	assert.Equal(t, []Issue{
+		{
+			Kind:        IssueUnterminatedTransport,
+			ElementKind: models.ElementKindTransport,
+			ElementID:   cloudLinkID.String(),
+			Name:        "cloud-link",
+			Message:     "transport 'cloud-link' has 1 attachment(s), 2 are required",
+		},
+		{
+			Kind:        IssueOrphanNode,
+			ElementKind: models.ElementKindNode,
+			ElementID:   awsID.String(),
+			Name:        "aws",
+			Message:     "node 'aws' is attached to no transport",
+		},
+		{
+			Kind:        IssueUnreachableCloud,
+			ElementKind: models.ElementKindNode,
+			ElementID:   londonID.String(),
+			Name:        "london",
+			Message:     "access node 'london' has no path to a cloud node",
+		},
+		{
+			Kind:        IssueUnreachableCloud,
+			ElementKind: models.ElementKindNode,
+			ElementID:   parisID.String(),
+			Name:        "paris",
+			Message:     "access node 'paris' has no path to a cloud node",
+		},
+	}, issues)
+}
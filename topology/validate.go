@@ -0,0 +1,128 @@
+package topology
+
+import (
+	"fmt"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+type IssueKind string
+
+const (
+	// IssueUnterminatedTransport is a transport with fewer than two attachments.
+	IssueUnterminatedTransport IssueKind = "unterminated_transport"
+	// IssueOrphanNode is a node attached to no transport.
+	IssueOrphanNode IssueKind = "orphan_node"
+	// IssueSideConflict is a transport with several attachments on the same side.
+	IssueSideConflict IssueKind = "side_conflict"
+	// IssueDanglingAttachment is an attachment whose node or transport does not exist.
+	IssueDanglingAttachment IssueKind = "dangling_attachment"
+	// IssueUnreachableCloud is an access node which has no path to any cloud node.
+	IssueUnreachableCloud IssueKind = "unreachable_cloud"
+)
+
+func (ik IssueKind) String() string {
+	return string(ik)
+}
+
+// Issue is a connectivity problem found in a topology.
+type Issue struct {
+	Kind        IssueKind          `json:"kind"`
+	ElementKind models.ElementKind `json:"elementKind"`
+	ElementID   string             `json:"elementId"`
+	Name        string             `json:"name,omitempty"`
+	Message     string             `json:"message"`
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s", i.Kind, i.Message)
+}
+
+// Validate checks the connectivity of the topology and returns the issues found on the transports,
+// then on the nodes, then on the attachments. The path check is skipped when the workspace has no cloud node.
+func (g *Graph) Validate() []Issue {
+	issues := []Issue{}
+
+	for _, edge := range g.SortedEdges() {
+		transport := edge.Transport
+		if len(edge.Attachments) < 2 {
+			issues = append(issues, Issue{
+				Kind:        IssueUnterminatedTransport,
+				ElementKind: models.ElementKindTransport,
+				ElementID:   transport.ID.String(),
+				Name:        transport.Name,
+				Message:     fmt.Sprintf("transport '%s' has %d attachment(s), 2 are required", transport.Name, len(edge.Attachments)),
+			})
+		}
+
+		sides := map[models.AttachmentSide]int{}
+		for _, attachment := range edge.Attachments {
+			if attachment.Side != "" {
+				sides[attachment.Side]++
+			}
+		}
+		for _, side := range []models.AttachmentSide{models.AttachmentSideA, models.AttachmentSideZ} {
+			if sides[side] > 1 {
+				issues = append(issues, Issue{
+					Kind:        IssueSideConflict,
+					ElementKind: models.ElementKindTransport,
+					ElementID:   transport.ID.String(),
+					Name:        transport.Name,
+					Message:     fmt.Sprintf("transport '%s' has %d attachments on side %s", transport.Name, sides[side], side),
+				})
+			}
+		}
+	}
+
+	vertices := g.SortedVertices()
+	clouds := []*Vertex{}
+	for _, vertex := range vertices {
+		if vertex.Node.Type == models.NodeTypeCloud {
+			clouds = append(clouds, vertex)
+		}
+	}
+
+	for _, vertex := range vertices {
+		node := vertex.Node
+		if len(vertex.Edges) == 0 {
+			issues = append(issues, Issue{
+				Kind:        IssueOrphanNode,
+				ElementKind: models.ElementKindNode,
+				ElementID:   node.ID.String(),
+				Name:        node.Name,
+				Message:     fmt.Sprintf("node '%s' is attached to no transport", node.Name),
+			})
+		}
+
+		if node.Type != models.NodeTypeAccess || len(clouds) == 0 {
+			continue
+		}
+		reachable := false
+		for _, cloud := range clouds {
+			if g.Connected(node.ID.String(), cloud.Node.ID.String()) {
+				reachable = true
+				break
+			}
+		}
+		if !reachable {
+			issues = append(issues, Issue{
+				Kind:        IssueUnreachableCloud,
+				ElementKind: models.ElementKindNode,
+				ElementID:   node.ID.String(),
+				Name:        node.Name,
+				Message:     fmt.Sprintf("access node '%s' has no path to a cloud node", node.Name),
+			})
+		}
+	}
+
+	for _, attachment := range g.Dangling {
+		issues = append(issues, Issue{
+			Kind:        IssueDanglingAttachment,
+			ElementKind: models.ElementKindAttachment,
+			ElementID:   attachment.ID.String(),
+			Message:     fmt.Sprintf("attachment %s links node %s and transport %s, one of them does not exist", attachment.ID, attachment.NodeID, attachment.TransportID),
+		})
+	}
+
+	return issues
+}
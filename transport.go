@@ -4,14 +4,22 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"iter"
 	"log"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/intercloud/autonomi-sdk/models"
 )
 
+var (
+	ErrVlanRequired    = errors.New("at least one vlan must be set")
+	ErrVlanAlreadyUsed = errors.New("vlan is already used")
+)
+
 func checkTransportFinishedTask(ctx context.Context, c *Client, workspaceID, transportID string, waiterOptionState models.AdministrativeState) (*models.Transport, bool) {
 	transport, err := c.GetTransport(ctx, workspaceID, transportID)
 	if err != nil {
@@ -28,6 +36,19 @@ func checkTransportFinishedTask(ctx context.Context, c *Client, workspaceID, tra
 	return transport, waiterOptionState == transport.State
 }
 
+// checkTransportProductUpdated returns a waiter check which is over once the transport is in the wanted state with product sku.
+// The product is checked as the transport may still be in state deployed right after the update request.
+func checkTransportProductUpdated(sku string) func(context.Context, *Client, string, string, models.AdministrativeState) (*models.Transport, bool) {
+	return func(ctx context.Context, c *Client, workspaceID, transportID string, waiterOptionState models.AdministrativeState) (*models.Transport, bool) {
+		transport, finishedTask := checkTransportFinishedTask(ctx, c, workspaceID, transportID, waiterOptionState)
+		if transport == nil {
+			return nil, false
+		}
+
+		return transport, finishedTask && transport.Product.SKU == sku
+	}
+}
+
 // CreateTransport creates asynchronously a transport. The transport returned will depend of the passed option.
 // If none is passed the transport will be returned once created in database with administrative state creation_pending.
 // If the option WithWaitUntilElementDeployed() is passed, the transport will be returned when its state reach deployed or creation_error.
@@ -48,6 +69,12 @@ func (c *Client) CreateTransport(ctx context.Context, payload models.CreateTrans
 		o(transportOptions)
 	}
 
+	if payload.Vlans != nil {
+		if err := c.checkVlansAvailable(ctx, *payload.Vlans, workspaceID, "", transportOptions); err != nil {
+			return nil, err
+		}
+	}
+
 	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/accounts/%s/workspaces/%s/transports", c.hostURL, c.accountID, workspaceID), body)
 	if err != nil {
 		return nil, err
@@ -102,6 +129,23 @@ func (c *Client) GetTransport(ctx context.Context, workspaceID, transportID stri
 	return &transport.Data, err
 }
 
+// ListTransports lists all the transports of a workspace, fetching every page.
+func (c *Client) ListTransports(ctx context.Context, workspaceID string, options ...ListOption) ([]models.Transport, error) {
+	return Collect(c.AllTransports(ctx, workspaceID, options...), 0)
+}
+
+// AllTransports returns an iterator over the transports of a workspace which lazily fetches the pages.
+func (c *Client) AllTransports(ctx context.Context, workspaceID string, options ...ListOption) iter.Seq2[models.Transport, error] {
+	return paginate(ctx, c, fmt.Sprintf("%s/accounts/%s/workspaces/%s/transports", c.hostURL, c.accountID, workspaceID), options, func(resp []byte) ([]models.Transport, *models.Pagination, error) {
+		transports := models.TransportsResponse{}
+		if err := json.Unmarshal(resp, &transports); err != nil {
+			return nil, nil, err
+		}
+
+		return transports.Data, transports.Meta, nil
+	})
+}
+
 func (c *Client) UpdateTransport(ctx context.Context, payload models.UpdateElement, workspaceID, transportID string) (*models.Transport, error) {
 	body := new(bytes.Buffer)
 	err := json.NewEncoder(body).Encode(&payload)
@@ -128,6 +172,172 @@ func (c *Client) UpdateTransport(ctx context.Context, payload models.UpdateEleme
 	return &transport.Data, err
 }
 
+// UpdateTransportProduct changes in place the product of a transport, e.g. to upgrade its bandwidth, without recreating it.
+// The new product must be compatible with the current one: only the bandwidth may change.
+// If the option WithWaitUntilElementDeployed() is passed, the transport will be returned once the modification is over,
+// i.e. when it is back in state deployed with the new product.
+func (c *Client) UpdateTransportProduct(ctx context.Context, payload models.UpdateElementProduct, workspaceID, transportID string, options ...OptionElement) (*models.Transport, error) {
+	if errV := c.validate.StructCtx(ctx, payload); errV != nil {
+		return nil, errV
+	}
+
+	transportOptions := &elementOptions{}
+	for _, o := range options {
+		o(transportOptions)
+	}
+
+	current, err := c.GetTransport(ctx, workspaceID, transportID)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := c.ListProducts(ctx, models.ProductFilter{Family: models.ProductFamilyTransport, SKU: payload.Product.SKU})
+	if err != nil {
+		return nil, err
+	}
+
+	target, found := catalog.TransportProduct(payload.Product.SKU)
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrProductNotFound, payload.Product.SKU)
+	}
+
+	if err := checkTransportProductCompatibility(current.Product, *target); err != nil {
+		return nil, err
+	}
+
+	body := new(bytes.Buffer)
+	err = json.NewEncoder(body).Encode(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/accounts/%s/workspaces/%s/transports/%s", c.hostURL, c.accountID, workspaceID, transportID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := models.TransportResponse{}
+	err = json.Unmarshal(resp, &transport)
+	if err != nil {
+		return nil, err
+	}
+
+	var transportPolled = &transport.Data
+	if transportOptions.waitUntilElementDeployed {
+		var success bool
+		transportPolled, success = WaitUntilFinishedTask(ctx, c, workspaceID, transport.Data.ID.String(), models.AdministrativeStateDeployed, checkTransportProductUpdated(payload.Product.SKU))
+		if !success {
+			return nil, fmt.Errorf("Transport did not reach '%s' state with product '%s' in time.", models.AdministrativeStateDeployed, payload.Product.SKU)
+		}
+	}
+
+	return transportPolled, nil
+}
+
+// UpdateTransportVlans changes the VLANs used on the A side and on the Z side of a transport.
+// If the option WithPhysicalPorts() is passed, the VLANs are checked against the VLANs already used on the ports.
+func (c *Client) UpdateTransportVlans(ctx context.Context, payload models.UpdateTransportVlans, workspaceID, transportID string, options ...OptionElement) (*models.Transport, error) {
+	if errV := c.validate.StructCtx(ctx, payload); errV != nil {
+		return nil, errV
+	}
+
+	if payload.Vlans.AVlan == 0 && payload.Vlans.ZVlan == 0 {
+		return nil, ErrVlanRequired
+	}
+
+	transportOptions := &elementOptions{}
+	for _, o := range options {
+		o(transportOptions)
+	}
+
+	if err := c.checkVlansAvailable(ctx, payload.Vlans, workspaceID, transportID, transportOptions); err != nil {
+		return nil, err
+	}
+
+	body := new(bytes.Buffer)
+	err := json.NewEncoder(body).Encode(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/accounts/%s/workspaces/%s/transports/%s", c.hostURL, c.accountID, workspaceID, transportID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := models.TransportResponse{}
+	err = json.Unmarshal(resp, &transport)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transport.Data, nil
+}
+
+// checkVlansAvailable makes sure the VLANs of a transport are not already used on the physical ports of its sides.
+// The transportID is empty when the transport is being created. Otherwise the VLANs currently held by the transport
+// on a port are not checked, as they are listed among the used VLANs of the port because of the transport itself.
+// When both sides are on the same port, this covers the VLANs of both sides, so that they can be swapped.
+func (c *Client) checkVlansAvailable(ctx context.Context, vlans models.TransportVlans, workspaceID, transportID string, options *elementOptions) error {
+	if options.aSidePhysicalPortID != "" && options.aSidePhysicalPortID == options.zSidePhysicalPortID && vlans.AVlan != 0 && vlans.AVlan == vlans.ZVlan {
+		return fmt.Errorf("%w: vlan %d cannot be used on both sides of physical port %s", ErrVlanAlreadyUsed, vlans.AVlan, options.aSidePhysicalPortID)
+	}
+
+	if options.aSidePhysicalPortID == "" && options.zSidePhysicalPortID == "" {
+		return nil
+	}
+
+	current := models.TransportVlans{}
+	if transportID != "" {
+		transport, err := c.GetTransport(ctx, workspaceID, transportID)
+		if err != nil {
+			return err
+		}
+		current = transport.TransportVlans
+	}
+
+	samePort := options.aSidePhysicalPortID == options.zSidePhysicalPortID
+	sides := []struct {
+		name   string
+		vlan   int64
+		held   []int64
+		portID string
+	}{
+		{"A", vlans.AVlan, []int64{current.AVlan}, options.aSidePhysicalPortID},
+		{"Z", vlans.ZVlan, []int64{current.ZVlan}, options.zSidePhysicalPortID},
+	}
+	if samePort {
+		sides[0].held = append(sides[0].held, current.ZVlan)
+		sides[1].held = append(sides[1].held, current.AVlan)
+	}
+	for _, side := range sides {
+		if side.vlan == 0 || side.portID == "" || slices.Contains(side.held, side.vlan) {
+			continue
+		}
+
+		port, err := c.GetPhysicalPort(ctx, side.portID)
+		if err != nil {
+			return err
+		}
+
+		if slices.Contains(port.UsedVLANs, side.vlan) {
+			return fmt.Errorf("%w: %s side vlan %d is already used on physical port %s", ErrVlanAlreadyUsed, side.name, side.vlan, side.portID)
+		}
+	}
+
+	return nil
+}
+
 // DeleteTransport deletes asynchronously a transport. The transport returned will depend of the option passed.
 // If none is passed the transport will be returned once the request accepted, its state will be delete_pending
 // If the option WithWaitUntilElementUndeployed() is passed, the transport won't be returned as it would have been deleted. However, if an error is triggered, an object could be returned with a delete_error state.
@@ -707,6 +707,44 @@ func TestGetTransportNotFound(t *testing.T) {
 	g.Expect(data).Should(BeNil())
 }
 
+func TestListTransportsSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	result := models.TransportsResponse{
+		Data: []models.Transport{transportDeployedResponse.Data},
+	}
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/transports", accountId, workspaceID)),
+			gh.VerifyHeaderKV("Authorization", "Bearer "+personalAccessToken), //nolint
+			gh.RespondWithJSONEncoded(http.StatusOK, result),
+		),
+	)
+
+	data, err := cli.ListTransports(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(Equal(result.Data))
+}
+
+func TestListTransportsForbidden(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/transports", accountId, workspaceID)),
+			gh.RespondWithJSONEncoded(http.StatusForbidden, nil),
+		),
+	)
+
+	data, err := cli.ListTransports(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(BeNil())
+	g.Expect(data).Should(BeNil())
+}
+
 func TestUpdateTransportSuccessfully(t *testing.T) {
 	g := NewWithT(t)
 	gh := ghttp.NewGHTTPWithGomega(g)
@@ -1052,3 +1090,312 @@ func TestDeleteTransportForbidden(t *testing.T) {
 	g.Expect(err).ShouldNot(BeNil())
 	g.Expect(data).Should(BeNil())
 }
+
+func TestUpdateTransportProductSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	// ends of the transport may be given in any order
+	upgradedProduct := transportDeployedResponse.Data.Product
+	upgradedProduct.Bandwidth = 1000
+	upgradedProduct.SKU = "TEQULD5FR51000"
+	upgradedProduct.Location, upgradedProduct.LocationTo = upgradedProduct.LocationTo, upgradedProduct.Location
+
+	updatePending := transportDeployedResponse
+	updatePending.Data.State = models.AdministrativeStateUpdatePending
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/transports/%s", accountId, workspaceID, transportID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, transportDeployedResponse),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/products", accountId), "family=transport&sku=TEQULD5FR51000"),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.ProductCatalogResponse{
+				Data: models.ProductCatalog{Transports: []models.TransportProduct{upgradedProduct}},
+			}),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPatch, fmt.Sprintf("/accounts/%s/workspaces/%s/transports/%s", accountId, workspaceID, transportID)),
+			gh.VerifyJSONRepresenting(models.UpdateElementProduct{Product: models.AddProduct{SKU: "TEQULD5FR51000"}}),
+			gh.RespondWithJSONEncoded(http.StatusAccepted, updatePending),
+		),
+	)
+
+	data, err := cli.UpdateTransportProduct(
+		context.Background(),
+		models.UpdateElementProduct{Product: models.AddProduct{SKU: "TEQULD5FR51000"}},
+		workspaceID,
+		transportID.String(),
+	)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(updatePending.Data))
+}
+
+func TestUpdateTransportProductIncompatible(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	otherEndProduct := transportDeployedResponse.Data.Product
+	otherEndProduct.SKU = "TEQUFR5AM31000"
+	otherEndProduct.LocationTo = "EQUINIX AM3"
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/transports/%s", accountId, workspaceID, transportID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, transportDeployedResponse),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/products", accountId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, models.ProductCatalogResponse{
+				Data: models.ProductCatalog{Transports: []models.TransportProduct{otherEndProduct}},
+			}),
+		),
+	)
+
+	data, err := cli.UpdateTransportProduct(
+		context.Background(),
+		models.UpdateElementProduct{Product: models.AddProduct{SKU: "TEQUFR5AM31000"}},
+		workspaceID,
+		transportID.String(),
+	)
+
+	g.Expect(err).Should(MatchError(ErrIncompatibleProduct))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestUpdateTransportProductFailedValidator(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.UpdateTransportProduct(
+		context.Background(),
+		models.UpdateElementProduct{},
+		workspaceID,
+		transportID.String(),
+	)
+
+	g.Expect(err.Error()).Should(Equal("Key: 'UpdateElementProduct.Product.SKU' Error:Field validation for 'SKU' failed on the 'required' tag"))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestCreateTransportWithVlansSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	port := portCreatedSingleResponse
+	port.Data.UsedVLANs = []int64{100, 101}
+
+	created := transportDeployedResponse
+	created.Data.State = models.AdministrativeStateCreationPending
+	created.Data.TransportVlans = models.TransportVlans{AVlan: 102, ZVlan: 200}
+
+	payload := models.CreateTransport{
+		Name:    "transport_name",
+		Product: models.AddProduct{SKU: "CEQUFR5100AWS"},
+		Vlans:   &models.TransportVlans{AVlan: 102, ZVlan: 200},
+	}
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/ports/%s", accountId, physicalPortId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, port),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPost, fmt.Sprintf("/accounts/%s/workspaces/%s/transports", accountId, workspaceID)),
+			gh.VerifyJSONRepresenting(payload),
+			gh.RespondWithJSONEncoded(http.StatusAccepted, created),
+		),
+	)
+
+	data, err := cli.CreateTransport(context.Background(), payload, workspaceID, WithPhysicalPorts(physicalPortId.String(), ""))
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data.TransportVlans).Should(Equal(created.Data.TransportVlans))
+}
+
+func TestCreateTransportWithVlanAlreadyUsed(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	port := portCreatedSingleResponse
+	port.Data.UsedVLANs = []int64{100, 200}
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/ports/%s", accountId, physicalPortId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, port),
+		),
+	)
+
+	data, err := cli.CreateTransport(
+		context.Background(),
+		models.CreateTransport{
+			Name:    "transport_name",
+			Product: models.AddProduct{SKU: "CEQUFR5100AWS"},
+			Vlans:   &models.TransportVlans{AVlan: 102, ZVlan: 200},
+		},
+		workspaceID,
+		WithPhysicalPorts("", physicalPortId.String()),
+	)
+
+	g.Expect(err).Should(MatchError(ErrVlanAlreadyUsed))
+	g.Expect(err.Error()).Should(ContainSubstring("Z side vlan 200"))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestCreateTransportWithVlansFailedValidator(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.CreateTransport(
+		context.Background(),
+		models.CreateTransport{
+			Name:    "transport_name",
+			Product: models.AddProduct{SKU: "CEQUFR5100AWS"},
+			Vlans:   &models.TransportVlans{AVlan: 4095},
+		},
+		workspaceID,
+	)
+
+	g.Expect(err.Error()).Should(Equal("Key: 'CreateTransport.Vlans.AVlan' Error:Field validation for 'AVlan' failed on the 'max' tag"))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestUpdateTransportVlansSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	updated := transportDeployedResponse
+	updated.Data.TransportVlans = models.TransportVlans{AVlan: 300}
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPatch, fmt.Sprintf("/accounts/%s/workspaces/%s/transports/%s", accountId, workspaceID, transportID)),
+			gh.VerifyJSONRepresenting(models.UpdateTransportVlans{Vlans: models.TransportVlans{AVlan: 300}}),
+			gh.RespondWithJSONEncoded(http.StatusOK, updated),
+		),
+	)
+
+	data, err := cli.UpdateTransportVlans(
+		context.Background(),
+		models.UpdateTransportVlans{Vlans: models.TransportVlans{AVlan: 300}},
+		workspaceID,
+		transportID.String(),
+	)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*data).Should(Equal(updated.Data))
+}
+
+func TestUpdateTransportVlansKeepingCurrentVlan(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	current := transportDeployedResponse
+	current.Data.TransportVlans = models.TransportVlans{AVlan: 100, ZVlan: 200}
+
+	// the A side vlan is used by the transport itself
+	port := portCreatedSingleResponse
+	port.Data.UsedVLANs = []int64{100, 200}
+
+	updated := current
+	updated.Data.TransportVlans = models.TransportVlans{AVlan: 100, ZVlan: 300}
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/transports/%s", accountId, workspaceID, transportID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, current),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/ports/%s", accountId, physicalPortId)),
+			gh.RespondWithJSONEncoded(http.StatusOK, port),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPatch, fmt.Sprintf("/accounts/%s/workspaces/%s/transports/%s", accountId, workspaceID, transportID)),
+			gh.VerifyJSONRepresenting(models.UpdateTransportVlans{Vlans: updated.Data.TransportVlans}),
+			gh.RespondWithJSONEncoded(http.StatusOK, updated),
+		),
+	)
+
+	data, err := cli.UpdateTransportVlans(
+		context.Background(),
+		models.UpdateTransportVlans{Vlans: updated.Data.TransportVlans},
+		workspaceID,
+		transportID.String(),
+		WithPhysicalPorts(physicalPortId.String(), physicalPortId.String()),
+	)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data.TransportVlans).Should(Equal(updated.Data.TransportVlans))
+}
+
+func TestUpdateTransportVlansSwappingSidesOnSamePort(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	current := transportDeployedResponse
+	current.Data.TransportVlans = models.TransportVlans{AVlan: 100, ZVlan: 200}
+
+	// both vlans are used by the transport itself on the shared port
+	port := portCreatedSingleResponse
+	port.Data.UsedVLANs = []int64{100, 200}
+
+	updated := current
+	updated.Data.TransportVlans = models.TransportVlans{AVlan: 200, ZVlan: 100}
+
+	server.AppendHandlers(
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/transports/%s", accountId, workspaceID, transportID)),
+			gh.RespondWithJSONEncoded(http.StatusOK, current),
+		),
+		ghttp.CombineHandlers(
+			gh.VerifyRequest(http.MethodPatch, fmt.Sprintf("/accounts/%s/workspaces/%s/transports/%s", accountId, workspaceID, transportID)),
+			gh.VerifyJSONRepresenting(models.UpdateTransportVlans{Vlans: updated.Data.TransportVlans}),
+			gh.RespondWithJSONEncoded(http.StatusOK, updated),
+		),
+	)
+
+	data, err := cli.UpdateTransportVlans(
+		context.Background(),
+		models.UpdateTransportVlans{Vlans: updated.Data.TransportVlans},
+		workspaceID,
+		transportID.String(),
+		WithPhysicalPorts(physicalPortId.String(), physicalPortId.String()),
+	)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data.TransportVlans).Should(Equal(updated.Data.TransportVlans))
+}
+
+func TestUpdateTransportVlansSamePortAndVlan(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.UpdateTransportVlans(
+		context.Background(),
+		models.UpdateTransportVlans{Vlans: models.TransportVlans{AVlan: 300, ZVlan: 300}},
+		workspaceID,
+		transportID.String(),
+		WithPhysicalPorts(physicalPortId.String(), physicalPortId.String()),
+	)
+
+	g.Expect(err).Should(MatchError(ErrVlanAlreadyUsed))
+	g.Expect(data).Should(BeNil())
+}
+
+func TestUpdateTransportVlansRequired(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	data, err := cli.UpdateTransportVlans(
+		context.Background(),
+		models.UpdateTransportVlans{},
+		workspaceID,
+		transportID.String(),
+	)
+
+	g.Expect(err).Should(Equal(ErrVlanRequired))
+	g.Expect(data).Should(BeNil())
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -40,23 +41,26 @@ func (c *Client) CreateUser(ctx context.Context, payload models.CreateUser) (*mo
 	return &user, nil
 }
 
-func (c *Client) ListUsers(ctx context.Context, accountID uuid.UUID) (models.Users, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/accounts/%s/users", c.hostURL, accountID), nil)
+// ListUsers lists all the users of an account, fetching every page.
+func (c *Client) ListUsers(ctx context.Context, accountID uuid.UUID, options ...ListOption) (models.Users, error) {
+	users, err := Collect(c.AllUsers(ctx, accountID, options...), 0)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+	return users, nil
+}
 
-	users := models.Users{}
-	if err = json.Unmarshal(resp, &users); err != nil {
-		return nil, err
-	}
+// AllUsers returns an iterator over the users of an account which lazily fetches the pages.
+func (c *Client) AllUsers(ctx context.Context, accountID uuid.UUID, options ...ListOption) iter.Seq2[models.User, error] {
+	return paginate(ctx, c, fmt.Sprintf("%s/accounts/%s/users", c.hostURL, accountID), options, func(resp []byte) ([]models.User, *models.Pagination, error) {
+		users := models.Users{}
+		if err := json.Unmarshal(resp, &users); err != nil {
+			return nil, nil, err
+		}
 
-	return users, nil
+		return users, nil, nil
+	})
 }
 
 func (c *Client) DeleteUser(ctx context.Context, userID string) error {
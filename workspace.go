@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -40,23 +41,21 @@ func (c *Client) CreateWorkspace(ctx context.Context, payload models.CreateWorks
 	return &workspace.Data, nil
 }
 
-func (c *Client) ListWorkspaces(ctx context.Context, accountID uuid.UUID) ([]models.Workspace, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/accounts/%s/workspaces", c.hostURL, accountID), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// ListWorkspaces lists all the workspaces of an account, fetching every page.
+func (c *Client) ListWorkspaces(ctx context.Context, accountID uuid.UUID, options ...ListOption) ([]models.Workspace, error) {
+	return Collect(c.AllWorkspaces(ctx, accountID, options...), 0)
+}
 
-	workspaces := models.WorkspacesResponse{}
-	if err = json.Unmarshal(resp, &workspaces); err != nil {
-		return nil, err
-	}
+// AllWorkspaces returns an iterator over the workspaces of an account which lazily fetches the pages.
+func (c *Client) AllWorkspaces(ctx context.Context, accountID uuid.UUID, options ...ListOption) iter.Seq2[models.Workspace, error] {
+	return paginate(ctx, c, fmt.Sprintf("%s/accounts/%s/workspaces", c.hostURL, accountID), options, func(resp []byte) ([]models.Workspace, *models.Pagination, error) {
+		workspaces := models.WorkspacesResponse{}
+		if err := json.Unmarshal(resp, &workspaces); err != nil {
+			return nil, nil, err
+		}
 
-	return workspaces.Data, nil
+		return workspaces.Data, workspaces.Meta, nil
+	})
 }
 
 func (c *Client) GetWorkspace(ctx context.Context, workspaceID string) (*models.Workspace, error) {
@@ -0,0 +1,208 @@
+package autonomisdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+const (
+	defaultDeleteRetries     = 2
+	defaultDeleteConcurrency = 4
+)
+
+type deleteWorkspaceOptions struct {
+	dryRun      bool
+	retries     int
+	concurrency int
+}
+
+type OptionDeleteWorkspace func(*deleteWorkspaceOptions)
+
+// WithDryRun lists the elements which would be deleted without deleting anything.
+func WithDryRun() OptionDeleteWorkspace {
+	return func(o *deleteWorkspaceOptions) {
+		o.dryRun = true
+	}
+}
+
+// WithDeleteRetries sets how many times the deletion of an element ending in delete_error is retried, 2 by default.
+func WithDeleteRetries(retries int) OptionDeleteWorkspace {
+	return func(o *deleteWorkspaceOptions) {
+		o.retries = retries
+	}
+}
+
+// WithDeleteConcurrency sets the maximum number of elements deleted at the same time, 4 by default.
+func WithDeleteConcurrency(concurrency int) OptionDeleteWorkspace {
+	return func(o *deleteWorkspaceOptions) {
+		o.concurrency = concurrency
+	}
+}
+
+// WorkspaceDeletion lists the elements of a workspace deleted, or which would be deleted in dry-run mode.
+type WorkspaceDeletion struct {
+	WorkspaceID string
+	DryRun      bool
+	Attachments []models.Attachment
+	Transports  []models.Transport
+	Nodes       []models.Node
+}
+
+// String lists the elements in deletion order, one per line.
+func (wd *WorkspaceDeletion) String() string {
+	var b strings.Builder
+	for _, attachment := range wd.Attachments {
+		fmt.Fprintf(&b, "attachment %s (node %s, transport %s)\n", attachment.ID, attachment.NodeID, attachment.TransportID)
+	}
+	for _, transport := range wd.Transports {
+		fmt.Fprintf(&b, "transport %s (%s)\n", transport.Name, transport.ID)
+	}
+	for _, node := range wd.Nodes {
+		fmt.Fprintf(&b, "node %s (%s)\n", node.Name, node.ID)
+	}
+	fmt.Fprintf(&b, "workspace %s\n", wd.WorkspaceID)
+
+	return b.String()
+}
+
+// DeleteWorkspaceRecursive deletes a workspace and all its elements. The attachments are deleted first, then
+// the transports and the nodes, each phase starting once all the elements of the previous one reached the deleted
+// state. The elements of a phase are deleted concurrently, at most 4 at the same time unless the option
+// WithDeleteConcurrency() is passed, and a deletion ending in delete_error is retried.
+// The workspace itself is deleted last. If the option WithDryRun() is passed, nothing is deleted and the
+// returned deletion lists what would be removed.
+func (c *Client) DeleteWorkspaceRecursive(ctx context.Context, workspaceID string, options ...OptionDeleteWorkspace) (*WorkspaceDeletion, error) {
+	deleteOptions := &deleteWorkspaceOptions{retries: defaultDeleteRetries, concurrency: defaultDeleteConcurrency}
+	for _, o := range options {
+		o(deleteOptions)
+	}
+
+	inventory, err := c.GetWorkspaceInventory(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	// a partial inventory would leave elements behind and the workspace deletion would fail
+	if err = inventory.Err(); err != nil {
+		return nil, err
+	}
+
+	// the elements already deleted are left out, there is nothing to remove
+	deletion := &WorkspaceDeletion{
+		WorkspaceID: workspaceID,
+		DryRun:      deleteOptions.dryRun,
+		Attachments: slices.DeleteFunc(inventory.Attachments, func(a models.Attachment) bool { return a.State == models.AdministrativeStateDeleted }),
+		Transports:  slices.DeleteFunc(inventory.Transports, func(t models.Transport) bool { return t.State == models.AdministrativeStateDeleted }),
+		Nodes:       slices.DeleteFunc(inventory.Nodes, func(n models.Node) bool { return n.State == models.AdministrativeStateDeleted }),
+	}
+	if deleteOptions.dryRun {
+		return deletion, nil
+	}
+
+	phase := []func(context.Context) error{}
+	for _, attachment := range deletion.Attachments {
+		phase = append(phase, func(ctx context.Context) error {
+			return deleteUntilDeleted(ctx, c, workspaceID, attachment.ID.String(), attachment.State, deleteOptions.retries, c.DeleteAttachment, checkAttachmentFinishedTask)
+		})
+	}
+	if err = runDeletionPhase(ctx, phase, deleteOptions.concurrency); err != nil {
+		return deletion, fmt.Errorf("cannot delete attachments: %w", err)
+	}
+
+	phase = []func(context.Context) error{}
+	for _, transport := range deletion.Transports {
+		phase = append(phase, func(ctx context.Context) error {
+			return deleteUntilDeleted(ctx, c, workspaceID, transport.ID.String(), transport.State, deleteOptions.retries, c.DeleteTransport, checkTransportFinishedTask)
+		})
+	}
+	for _, node := range deletion.Nodes {
+		phase = append(phase, func(ctx context.Context) error {
+			return deleteUntilDeleted(ctx, c, workspaceID, node.ID.String(), node.State, deleteOptions.retries, c.DeleteNode, checkNodeFinishedTask)
+		})
+	}
+	if err = runDeletionPhase(ctx, phase, deleteOptions.concurrency); err != nil {
+		return deletion, fmt.Errorf("cannot delete transports and nodes: %w", err)
+	}
+
+	if err = c.DeleteWorkspace(ctx, workspaceID); err != nil {
+		return deletion, err
+	}
+
+	return deletion, nil
+}
+
+// runDeletionPhase runs the deletions, at most concurrency of them at the same time, and returns
+// their errors joined once all of them ended.
+func runDeletionPhase(ctx context.Context, deletions []func(context.Context) error, concurrency int) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		errs  []error
+		slots = make(chan struct{}, concurrency)
+	)
+	for _, deletion := range deletions {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := deletion(ctx); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// deleteUntilDeleted deletes an element and waits until it is gone. An element already deleted is left as is
+// and one being deleted is only awaited. The deletion is sent again up to retries times when the element
+// ends in delete_error.
+func deleteUntilDeleted[T Element](
+	ctx context.Context,
+	c *Client,
+	workspaceID, elementID string,
+	state models.AdministrativeState,
+	retries int,
+	deleteElement func(context.Context, string, string, ...OptionElement) (T, error),
+	getElement func(context.Context, *Client, string, string, models.AdministrativeState) (T, bool),
+) error {
+	if state == models.AdministrativeStateDeleted {
+		return nil
+	}
+
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if attempt == 0 && (state == models.AdministrativeStateDeletePending || state == models.AdministrativeStateDeleteProceed) {
+			if _, deleted := WaitUntilFinishedTask(ctx, c, workspaceID, elementID, models.AdministrativeStateDeleted, getElement); deleted {
+				return nil
+			}
+			err = fmt.Errorf("element %s did not reach '%s' state in time", elementID, models.AdministrativeStateDeleted)
+			continue
+		}
+
+		_, err = deleteElement(ctx, workspaceID, elementID, WithWaitUntilElementUndeployed())
+		// the element may have been deleted in the meantime
+		if err == nil || strings.Contains(err.Error(), "status: 404") {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("element %s: %w", elementID, err)
+}
@@ -0,0 +1,227 @@
+package autonomisdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/intercloud/autonomi-sdk/models"
+)
+
+// deletionAPI serves the elements of the workspace inventory by kind. A deleted element is gone
+// at the next poll, unless its kind is listed in failures, in which case it ends in delete_error.
+type deletionAPI struct {
+	mu       sync.Mutex
+	states   map[string]models.AdministrativeState
+	failures map[string]int
+	calls    []string
+}
+
+func (d *deletionAPI) handler(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		if r.Method == http.MethodDelete {
+			d.calls = append(d.calls, "delete "+kind)
+			d.states[kind] = models.AdministrativeStateDeleted
+			if d.failures[kind] > 0 {
+				d.failures[kind]--
+				d.states[kind] = models.AdministrativeStateDeleteError
+			}
+		}
+		if d.states[kind] == models.AdministrativeStateDeleted {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"id": attachmentID, "administrativeState": d.states[kind]},
+		})
+	}
+}
+
+func (d *deletionAPI) recorded() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]string{}, d.calls...)
+}
+
+func routeWorkspaceDeletion(api *deletionAPI) {
+	routeWorkspaceInventory(http.StatusOK)
+	for _, kind := range []string{"attachment", "transport", "node"} {
+		path := fmt.Sprintf("/accounts/%s/workspaces/%s/%ss/%s", accountId, workspaceID, kind, attachmentID)
+		server.RouteToHandler(http.MethodGet, path, api.handler(kind))
+		server.RouteToHandler(http.MethodDelete, path, api.handler(kind))
+	}
+	server.RouteToHandler(http.MethodDelete, fmt.Sprintf("/accounts/%s/workspaces/%s", accountId, workspaceID),
+		func(w http.ResponseWriter, r *http.Request) {
+			api.mu.Lock()
+			defer api.mu.Unlock()
+			api.calls = append(api.calls, "delete workspace")
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
+	cli.poll = pollElement{retryInterval: 5 * time.Millisecond, maxRetry: 5}
+}
+
+func newDeletionAPI() *deletionAPI {
+	return &deletionAPI{
+		states: map[string]models.AdministrativeState{
+			"attachment": models.AdministrativeStateDeployed,
+			"transport":  models.AdministrativeStateDeployed,
+			"node":       models.AdministrativeStateDeployed,
+		},
+		failures: map[string]int{},
+	}
+}
+
+func TestDeleteWorkspaceRecursiveDryRun(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	api := newDeletionAPI()
+	routeWorkspaceDeletion(api)
+
+	deletion, err := cli.DeleteWorkspaceRecursive(context.Background(), workspaceID, WithDryRun())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(deletion.DryRun).Should(BeTrue())
+	g.Expect(deletion.Attachments).Should(Equal([]models.Attachment{attachmentDeployedResponse.Data}))
+	g.Expect(deletion.Transports).Should(Equal([]models.Transport{transportDeployedResponse.Data}))
+	g.Expect(deletion.Nodes).Should(Equal([]models.Node{nodeDeployedResponse.Data}))
+	g.Expect(deletion.String()).Should(HavePrefix("attachment " + attachmentID.String()))
+	g.Expect(deletion.String()).Should(HaveSuffix("workspace " + workspaceID + "\n"))
+	g.Expect(api.recorded()).Should(BeEmpty())
+}
+
+func TestDeleteWorkspaceRecursiveSkipsDeletedElements(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	api := newDeletionAPI()
+	routeWorkspaceDeletion(api)
+	deleted := nodeDeployedResponse.Data
+	deleted.State = models.AdministrativeStateDeleted
+	server.RouteToHandler(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/nodes", accountId, workspaceID),
+		gh.RespondWithJSONEncoded(http.StatusOK, models.NodesResponse{Data: []models.Node{deleted}}),
+	)
+
+	deletion, err := cli.DeleteWorkspaceRecursive(context.Background(), workspaceID, WithDryRun())
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(deletion.Nodes).Should(BeEmpty())
+	g.Expect(deletion.String()).ShouldNot(ContainSubstring("\nnode "))
+
+	_, err = cli.DeleteWorkspaceRecursive(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(api.recorded()).Should(Equal([]string{"delete attachment", "delete transport", "delete workspace"}))
+}
+
+func TestDeleteWorkspaceRecursiveSuccessfully(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	api := newDeletionAPI()
+	routeWorkspaceDeletion(api)
+
+	deletion, err := cli.DeleteWorkspaceRecursive(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(deletion.DryRun).Should(BeFalse())
+	calls := api.recorded()
+	g.Expect(calls).Should(HaveLen(4))
+	g.Expect(calls[0]).Should(Equal("delete attachment"))
+	g.Expect(calls[1:3]).Should(ConsistOf("delete transport", "delete node"))
+	g.Expect(calls[3]).Should(Equal("delete workspace"))
+}
+
+func TestDeleteWorkspaceRecursiveRetriesDeleteError(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	api := newDeletionAPI()
+	api.failures["node"] = 1
+	routeWorkspaceDeletion(api)
+	// the transport deletion is already in progress, it is only awaited until gone
+	transports := []models.Transport{transportDeployedResponse.Data}
+	transports[0].State = models.AdministrativeStateDeletePending
+	server.RouteToHandler(http.MethodGet, fmt.Sprintf("/accounts/%s/workspaces/%s/transports", accountId, workspaceID),
+		gh.RespondWithJSONEncoded(http.StatusOK, models.TransportsResponse{Data: transports}),
+	)
+	api.states["transport"] = models.AdministrativeStateDeleted
+
+	_, err := cli.DeleteWorkspaceRecursive(context.Background(), workspaceID)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(api.recorded()).Should(Equal([]string{"delete attachment", "delete node", "delete node", "delete workspace"}))
+}
+
+func TestDeleteWorkspaceRecursiveStopsAfterRetries(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	api := newDeletionAPI()
+	api.failures["attachment"] = 3
+	routeWorkspaceDeletion(api)
+
+	_, err := cli.DeleteWorkspaceRecursive(context.Background(), workspaceID, WithDeleteRetries(1))
+
+	g.Expect(err).Should(MatchError(ContainSubstring("cannot delete attachments")))
+	g.Expect(api.recorded()).Should(Equal([]string{"delete attachment", "delete attachment"}))
+}
+
+func TestDeleteWorkspaceRecursivePartialInventory(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	api := newDeletionAPI()
+	routeWorkspaceDeletion(api)
+	routeWorkspaceInventory(http.StatusInternalServerError)
+
+	deletion, err := cli.DeleteWorkspaceRecursive(context.Background(), workspaceID)
+
+	g.Expect(err).Should(MatchError(ContainSubstring("cannot list transports")))
+	g.Expect(deletion).Should(BeNil())
+	g.Expect(api.recorded()).Should(BeEmpty())
+}
+
+func TestRunDeletionPhaseCapsConcurrency(t *testing.T) {
+	tearDownTest := setupTest(t)
+	defer tearDownTest(t)
+
+	var (
+		mu                sync.Mutex
+		inFlight, maxSeen int
+	)
+	deletions := make([]func(context.Context) error, 10)
+	for i := range deletions {
+		deletions[i] = func(context.Context) error {
+			mu.Lock()
+			inFlight++
+			maxSeen = max(maxSeen, inFlight)
+			mu.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return nil
+		}
+	}
+
+	err := runDeletionPhase(context.Background(), deletions, 3)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(maxSeen).Should(Equal(3))
+}